@@ -0,0 +1,51 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+)
+
+// HandleAdminCommand implements the `briefly cache stats|purge <videoID>`
+// admin command surface against store. It is a plain function rather than a
+// full command-router integration so it can be wired into whichever bot
+// framework or CLI dispatches admin commands.
+//
+// Supported args:
+//   - {"stats"}: returns the cache's hit/miss/purge counters.
+//   - {"purge", videoID}: purges every entry ever indexed for videoID by
+//     IndexForVideo — every cached transcript language and every summary
+//     derived from them — rather than guessing at language codes.
+//
+// Returns:
+//   - A human-readable response string.
+//   - An error if args is malformed or the requested operation fails.
+func HandleAdminCommand(ctx context.Context, store Store, args []string) (string, error) {
+	if len(args) == 0 {
+		return "", fmt.Errorf("usage: cache stats|purge <videoID>")
+	}
+
+	switch args[0] {
+	case "stats":
+		stats := store.Stats()
+		slog.Info("cache stats", "hits", stats.Hits, "misses", stats.Misses, "purges", stats.Purges)
+		return fmt.Sprintf("hits=%d misses=%d purges=%d", stats.Hits, stats.Misses, stats.Purges), nil
+
+	case "purge":
+		if len(args) != 2 {
+			return "", fmt.Errorf("usage: cache purge <videoID>")
+		}
+		videoID := args[1]
+
+		purged, err := PurgeVideo(ctx, store, videoID)
+		if err != nil {
+			return "", fmt.Errorf("failed to purge cache for %s: %w", videoID, err)
+		}
+
+		slog.Info("cache purge", "videoID", videoID, "entries", len(purged))
+		return fmt.Sprintf("purged %d cache entries for %s", len(purged), videoID), nil
+
+	default:
+		return "", fmt.Errorf("unknown cache subcommand %q", args[0])
+	}
+}
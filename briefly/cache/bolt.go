@@ -0,0 +1,111 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"go.etcd.io/bbolt"
+)
+
+// boltBucket is the single bucket BoltStore keeps all entries in.
+var boltBucket = []byte("briefly_cache")
+
+// BoltStore is a Store backed by a local BoltDB file, for single-instance
+// deployments that want a cache to survive a process restart without
+// standing up Redis.
+type BoltStore struct {
+	counters
+
+	db *bbolt.DB
+}
+
+// boltEntry is the JSON envelope BoltStore stores each value in, carrying
+// the expiry alongside the cached bytes.
+type boltEntry struct {
+	Value     []byte    `json:"value"`
+	ExpiresAt time.Time `json:"expires_at"` // zero means "no expiry"
+}
+
+// NewBoltStore opens (creating if necessary) a BoltDB file at path.
+func NewBoltStore(path string) (*BoltStore, error) {
+	db, err := bbolt.Open(path, 0o600, &bbolt.Options{Timeout: 5 * time.Second})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open bolt cache at %s: %w", path, err)
+	}
+
+	err = db.Update(func(tx *bbolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(boltBucket)
+		return err
+	})
+	if err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("failed to create bolt cache bucket: %w", err)
+	}
+
+	return &BoltStore{db: db}, nil
+}
+
+// Close releases the underlying BoltDB file handle.
+func (s *BoltStore) Close() error {
+	return s.db.Close()
+}
+
+// Get returns the cached value for key, and whether it was found.
+func (s *BoltStore) Get(_ context.Context, key string) ([]byte, bool, error) {
+	var entry *boltEntry
+
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		raw := tx.Bucket(boltBucket).Get([]byte(key))
+		if raw == nil {
+			return nil
+		}
+		entry = &boltEntry{}
+		return json.Unmarshal(raw, entry)
+	})
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to read bolt cache entry: %w", err)
+	}
+	if entry == nil {
+		s.recordMiss()
+		return nil, false, nil
+	}
+	if !entry.ExpiresAt.IsZero() && time.Now().After(entry.ExpiresAt) {
+		_ = s.Purge(context.Background(), key)
+		s.recordMiss()
+		return nil, false, nil
+	}
+
+	s.recordHit()
+	return entry.Value, true, nil
+}
+
+// Put caches value under key for ttl. A zero ttl means "no expiry".
+func (s *BoltStore) Put(_ context.Context, key string, value []byte, ttl time.Duration) error {
+	var expiresAt time.Time
+	if ttl > 0 {
+		expiresAt = time.Now().Add(ttl)
+	}
+
+	raw, err := json.Marshal(boltEntry{Value: value, ExpiresAt: expiresAt})
+	if err != nil {
+		return fmt.Errorf("failed to encode bolt cache entry: %w", err)
+	}
+
+	return s.db.Update(func(tx *bbolt.Tx) error {
+		return tx.Bucket(boltBucket).Put([]byte(key), raw)
+	})
+}
+
+// Purge removes key from the cache, if present.
+func (s *BoltStore) Purge(_ context.Context, key string) error {
+	err := s.db.Update(func(tx *bbolt.Tx) error {
+		return tx.Bucket(boltBucket).Delete([]byte(key))
+	})
+	if err != nil {
+		return fmt.Errorf("failed to purge bolt cache entry: %w", err)
+	}
+	s.recordPurge()
+	return nil
+}
@@ -0,0 +1,91 @@
+package cache
+
+import (
+	"fmt"
+	"log/slog"
+	"os"
+	"strconv"
+	"sync"
+)
+
+// defaultLRUCapacity bounds the in-memory LRU backend when
+// CACHE_MEMORY_CAPACITY isn't set.
+const defaultLRUCapacity = 1024
+
+// defaultStoreOnce and defaultStoreVal back Default, so every caller in the
+// process shares one Store instance instead of each opening its own (which,
+// for CACHE_BACKEND=bolt, would mean two bbolt.Open calls against the same
+// file: the second blocks on the file lock and then fails).
+var (
+	defaultStoreOnce sync.Once
+	defaultStoreVal  Store
+)
+
+// Default returns the process-wide Store built from the environment on
+// first use (see NewStoreFromEnv), logging (but not failing the caller on)
+// a misconfiguration. Every package that needs a cache (transcript,
+// summary, ...) should call Default instead of NewStoreFromEnv directly, so
+// they all share the same backend connection.
+func Default() Store {
+	defaultStoreOnce.Do(func() {
+		store, err := NewStoreFromEnv()
+		if err != nil {
+			slog.Warn("Failed to initialize cache, continuing without it", "error", err)
+			return
+		}
+		defaultStoreVal = store
+	})
+	return defaultStoreVal
+}
+
+// NewStoreFromEnv builds the Store selected by CACHE_BACKEND ("memory",
+// "bolt", or "redis"; defaults to "memory"), reading each backend's settings
+// from its own environment variables:
+//   - memory: CACHE_MEMORY_CAPACITY (entry count, default 1024)
+//   - bolt: CACHE_BOLT_PATH (required)
+//   - redis: CACHE_REDIS_ADDR (required), CACHE_REDIS_PREFIX (default "briefly:")
+//
+// Returns:
+//   - A Store ready to use.
+//   - An error if CACHE_BACKEND is unknown or a required backend-specific
+//     variable is missing.
+func NewStoreFromEnv() (Store, error) {
+	backend := os.Getenv("CACHE_BACKEND")
+	if backend == "" {
+		backend = "memory"
+	}
+
+	switch backend {
+	case "memory":
+		capacity := defaultLRUCapacity
+		if raw := os.Getenv("CACHE_MEMORY_CAPACITY"); raw != "" {
+			parsed, err := strconv.Atoi(raw)
+			if err != nil {
+				return nil, fmt.Errorf("invalid CACHE_MEMORY_CAPACITY: %w", err)
+			}
+			capacity = parsed
+		}
+		return NewLRUStore(capacity), nil
+
+	case "bolt":
+		path := os.Getenv("CACHE_BOLT_PATH")
+		if path == "" {
+			return nil, fmt.Errorf("CACHE_BOLT_PATH not set")
+		}
+		return NewBoltStore(path)
+
+	case "redis":
+		addr := os.Getenv("CACHE_REDIS_ADDR")
+		if addr == "" {
+			return nil, fmt.Errorf("CACHE_REDIS_ADDR not set")
+		}
+		prefix := os.Getenv("CACHE_REDIS_PREFIX")
+		if prefix == "" {
+			prefix = "briefly:"
+		}
+		return NewRedisStore(addr, prefix), nil
+
+	default:
+		return nil, fmt.Errorf("unknown CACHE_BACKEND %q", backend)
+	}
+}
@@ -0,0 +1,20 @@
+package cache
+
+import "context"
+
+// videoIDContextKey is the context.Context key used by WithVideoID.
+type videoIDContextKey struct{}
+
+// WithVideoID attaches videoID to ctx, so a cache write further down the
+// call chain (e.g. a summary generated from this video's transcript) can
+// register itself in that video's index via IndexForVideo, letting
+// `cache purge <videoID>` sweep the summary too.
+func WithVideoID(ctx context.Context, videoID string) context.Context {
+	return context.WithValue(ctx, videoIDContextKey{}, videoID)
+}
+
+// VideoIDFromContext returns the video ID attached by WithVideoID, if any.
+func VideoIDFromContext(ctx context.Context) (string, bool) {
+	videoID, ok := ctx.Value(videoIDContextKey{}).(string)
+	return videoID, ok && videoID != ""
+}
@@ -0,0 +1,23 @@
+package cache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+)
+
+// TranscriptKey builds the cache key for a video's transcript, keyed by
+// video ID and subtitle language so different languages don't collide.
+func TranscriptKey(videoID string, languageCode string) string {
+	return fmt.Sprintf("transcript:%s:%s", videoID, languageCode)
+}
+
+// SummaryKey builds the cache key for a summary, keyed by the SHA-256 of
+// prompt (whatever string identifies the prompt template and source text;
+// summarization passes the template key joined with the text) together with
+// the model and language, so a change to either the source text or the
+// model invalidates the cached entry.
+func SummaryKey(prompt string, model string, lang string) string {
+	sum := sha256.Sum256([]byte(prompt + "\x00" + model + "\x00" + lang))
+	return fmt.Sprintf("summary:%s", hex.EncodeToString(sum[:]))
+}
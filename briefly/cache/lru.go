@@ -0,0 +1,107 @@
+package cache
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+)
+
+// LRUStore is an in-memory Store bounded by entry count, evicting the
+// least-recently-used entry when full. It is the default backend, used when
+// no durable cache is configured.
+type LRUStore struct {
+	counters
+
+	mu       sync.Mutex
+	capacity int
+	entries  map[string]*list.Element
+	order    *list.List // front = most recently used
+}
+
+// lruEntry is the payload stored in LRUStore.order.
+type lruEntry struct {
+	key       string
+	value     []byte
+	expiresAt time.Time // zero means "no expiry"
+}
+
+// NewLRUStore builds an LRUStore holding at most capacity entries.
+func NewLRUStore(capacity int) *LRUStore {
+	if capacity <= 0 {
+		capacity = 1
+	}
+	return &LRUStore{
+		capacity: capacity,
+		entries:  make(map[string]*list.Element, capacity),
+		order:    list.New(),
+	}
+}
+
+// Get returns the cached value for key, and whether it was found.
+func (s *LRUStore) Get(_ context.Context, key string) ([]byte, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	elem, ok := s.entries[key]
+	if !ok {
+		s.recordMiss()
+		return nil, false, nil
+	}
+
+	entry := elem.Value.(*lruEntry)
+	if !entry.expiresAt.IsZero() && time.Now().After(entry.expiresAt) {
+		s.removeElement(elem)
+		s.recordMiss()
+		return nil, false, nil
+	}
+
+	s.order.MoveToFront(elem)
+	s.recordHit()
+	return entry.value, true, nil
+}
+
+// Put caches value under key for ttl. A zero ttl means "no expiry".
+func (s *LRUStore) Put(_ context.Context, key string, value []byte, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var expiresAt time.Time
+	if ttl > 0 {
+		expiresAt = time.Now().Add(ttl)
+	}
+
+	if elem, ok := s.entries[key]; ok {
+		elem.Value = &lruEntry{key: key, value: value, expiresAt: expiresAt}
+		s.order.MoveToFront(elem)
+		return nil
+	}
+
+	elem := s.order.PushFront(&lruEntry{key: key, value: value, expiresAt: expiresAt})
+	s.entries[key] = elem
+
+	if s.order.Len() > s.capacity {
+		s.removeElement(s.order.Back())
+	}
+	return nil
+}
+
+// Purge removes key from the cache, if present.
+func (s *LRUStore) Purge(_ context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if elem, ok := s.entries[key]; ok {
+		s.removeElement(elem)
+		s.recordPurge()
+	}
+	return nil
+}
+
+// removeElement drops elem from both the lookup map and the LRU list. The
+// caller must hold s.mu.
+func (s *LRUStore) removeElement(elem *list.Element) {
+	entry := elem.Value.(*lruEntry)
+	delete(s.entries, entry.key)
+	s.order.Remove(elem)
+}
@@ -0,0 +1,100 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestLRUStoreEvictsLeastRecentlyUsed(t *testing.T) {
+	ctx := context.Background()
+	store := NewLRUStore(2)
+
+	_ = store.Put(ctx, "a", []byte("1"), 0)
+	_ = store.Put(ctx, "b", []byte("2"), 0)
+
+	// Touch "a" so "b" becomes the least recently used entry.
+	if _, ok, _ := store.Get(ctx, "a"); !ok {
+		t.Fatalf("expected %q to be cached", "a")
+	}
+
+	_ = store.Put(ctx, "c", []byte("3"), 0)
+
+	if _, ok, _ := store.Get(ctx, "b"); ok {
+		t.Fatalf("expected %q to have been evicted", "b")
+	}
+	if _, ok, _ := store.Get(ctx, "a"); !ok {
+		t.Fatalf("expected %q to still be cached", "a")
+	}
+	if _, ok, _ := store.Get(ctx, "c"); !ok {
+		t.Fatalf("expected %q to be cached", "c")
+	}
+}
+
+// TestLRUStoreCapacityOne covers the degenerate single-slot case, where
+// every Put must evict the previous entry.
+func TestLRUStoreCapacityOne(t *testing.T) {
+	ctx := context.Background()
+	store := NewLRUStore(1)
+
+	_ = store.Put(ctx, "a", []byte("1"), 0)
+	_ = store.Put(ctx, "b", []byte("2"), 0)
+
+	if _, ok, _ := store.Get(ctx, "a"); ok {
+		t.Fatalf("expected %q to have been evicted", "a")
+	}
+	if value, ok, _ := store.Get(ctx, "b"); !ok || string(value) != "2" {
+		t.Fatalf("Get(%q) = (%q, %v), want (\"2\", true)", "b", value, ok)
+	}
+}
+
+// TestLRUStoreNonPositiveCapacity ensures NewLRUStore never builds an
+// unusable zero-capacity store.
+func TestLRUStoreNonPositiveCapacity(t *testing.T) {
+	store := NewLRUStore(0)
+	if store.capacity != 1 {
+		t.Fatalf("NewLRUStore(0).capacity = %d, want 1", store.capacity)
+	}
+}
+
+func TestLRUStoreTTLExpiry(t *testing.T) {
+	ctx := context.Background()
+	store := NewLRUStore(2)
+
+	if err := store.Put(ctx, "a", []byte("1"), time.Nanosecond); err != nil {
+		t.Fatalf("Put returned error: %v", err)
+	}
+	time.Sleep(time.Millisecond)
+
+	if _, ok, err := store.Get(ctx, "a"); ok || err != nil {
+		t.Fatalf("Get(%q) = (ok=%v, err=%v), want (false, nil) once expired", "a", ok, err)
+	}
+}
+
+func TestLRUStoreZeroTTLNeverExpires(t *testing.T) {
+	ctx := context.Background()
+	store := NewLRUStore(2)
+
+	_ = store.Put(ctx, "a", []byte("1"), 0)
+	if _, ok, _ := store.Get(ctx, "a"); !ok {
+		t.Fatalf("expected zero-TTL entry to remain cached")
+	}
+}
+
+func TestLRUStorePurge(t *testing.T) {
+	ctx := context.Background()
+	store := NewLRUStore(2)
+
+	_ = store.Put(ctx, "a", []byte("1"), 0)
+	if err := store.Purge(ctx, "a"); err != nil {
+		t.Fatalf("Purge returned error: %v", err)
+	}
+	if _, ok, _ := store.Get(ctx, "a"); ok {
+		t.Fatalf("expected %q to be gone after Purge", "a")
+	}
+
+	stats := store.Stats()
+	if stats.Purges != 1 {
+		t.Fatalf("Stats().Purges = %d, want 1", stats.Purges)
+	}
+}
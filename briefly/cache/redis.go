@@ -0,0 +1,66 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// RedisStore is a Store backed by a Redis (or Redis-compatible) server,
+// for multi-instance deployments that need a shared cache.
+type RedisStore struct {
+	counters
+
+	client *redis.Client
+	prefix string
+}
+
+// NewRedisStore builds a RedisStore connecting to addr. keyPrefix namespaces
+// every key (e.g. "briefly:") so the cache can share a Redis instance with
+// other applications.
+func NewRedisStore(addr string, keyPrefix string) *RedisStore {
+	return &RedisStore{
+		client: redis.NewClient(&redis.Options{Addr: addr}),
+		prefix: keyPrefix,
+	}
+}
+
+// Close releases the underlying Redis connection pool.
+func (s *RedisStore) Close() error {
+	return s.client.Close()
+}
+
+// Get returns the cached value for key, and whether it was found.
+func (s *RedisStore) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	value, err := s.client.Get(ctx, s.prefix+key).Bytes()
+	if errors.Is(err, redis.Nil) {
+		s.recordMiss()
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to read redis cache entry: %w", err)
+	}
+
+	s.recordHit()
+	return value, true, nil
+}
+
+// Put caches value under key for ttl. A zero ttl means "no expiry".
+func (s *RedisStore) Put(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	if err := s.client.Set(ctx, s.prefix+key, value, ttl).Err(); err != nil {
+		return fmt.Errorf("failed to write redis cache entry: %w", err)
+	}
+	return nil
+}
+
+// Purge removes key from the cache, if present.
+func (s *RedisStore) Purge(ctx context.Context, key string) error {
+	if err := s.client.Del(ctx, s.prefix+key).Err(); err != nil {
+		return fmt.Errorf("failed to purge redis cache entry: %w", err)
+	}
+	s.recordPurge()
+	return nil
+}
@@ -0,0 +1,55 @@
+// Package cache provides a pluggable Store for caching transcripts and
+// summaries by video ID and model, so repeated requests for the same video
+// (common in Telegram group chats) return instantly without re-invoking
+// `yt-dlp` or the configured LLM.
+package cache
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+)
+
+// Store is a key/value cache with per-entry time-to-live. Implementations
+// back it with an in-memory LRU, BoltDB, or Redis.
+type Store interface {
+	// Get returns the cached value for key, and whether it was found.
+	// A missing or expired entry is reported as (nil, false, nil).
+	Get(ctx context.Context, key string) ([]byte, bool, error)
+	// Put caches value under key for ttl. A zero ttl means "no expiry".
+	Put(ctx context.Context, key string, value []byte, ttl time.Duration) error
+	// Purge removes key from the cache, if present.
+	Purge(ctx context.Context, key string) error
+	// Stats returns cache-hit metrics accumulated since the Store was created.
+	Stats() Stats
+}
+
+// Stats holds cache-hit metrics for a Store, exposed to the admin command
+// surface and surfaced via slog by the callers that wrap Get/Put.
+type Stats struct {
+	Hits   uint64
+	Misses uint64
+	Purges uint64
+}
+
+// counters is embedded by every Store implementation to track Stats without
+// duplicating the atomic bookkeeping in each backend.
+type counters struct {
+	hits   atomic.Uint64
+	misses atomic.Uint64
+	purges atomic.Uint64
+}
+
+func (c *counters) recordHit()   { c.hits.Add(1) }
+func (c *counters) recordMiss()  { c.misses.Add(1) }
+func (c *counters) recordPurge() { c.purges.Add(1) }
+
+// Stats returns the hit/miss/purge counts recorded so far, satisfying
+// Store.Stats for every backend that embeds counters.
+func (c *counters) Stats() Stats {
+	return Stats{
+		Hits:   c.hits.Load(),
+		Misses: c.misses.Load(),
+		Purges: c.purges.Load(),
+	}
+}
@@ -0,0 +1,89 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// videoIndexKey namespaces the index entry that records every cache key
+// (transcript or summary) ever stored for a given video, so a purge by
+// video ID can be exhaustive instead of guessing at language codes.
+func videoIndexKey(videoID string) string {
+	return fmt.Sprintf("video-index:%s", videoID)
+}
+
+// videoIndex is the JSON envelope stored under videoIndexKey.
+type videoIndex struct {
+	Keys []string `json:"keys"`
+}
+
+// IndexForVideo records that key was cached on behalf of videoID, so a
+// later PurgeVideo(videoID) can find and remove it. Callers that cache a
+// transcript or summary for a known video should call this alongside Put.
+func IndexForVideo(ctx context.Context, store Store, videoID string, key string) error {
+	if videoID == "" {
+		return nil
+	}
+
+	entry, err := readVideoIndex(ctx, store, videoID)
+	if err != nil {
+		return err
+	}
+
+	for _, existing := range entry.Keys {
+		if existing == key {
+			return nil
+		}
+	}
+	entry.Keys = append(entry.Keys, key)
+
+	raw, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("failed to encode video cache index: %w", err)
+	}
+	return store.Put(ctx, videoIndexKey(videoID), raw, 0)
+}
+
+// PurgeVideo removes every cache entry recorded for videoID by IndexForVideo
+// (transcripts and summaries alike), then removes the index itself.
+//
+// Returns:
+//   - The keys that were purged, for logging.
+//   - An error if reading the index or purging an entry fails. Entries
+//     purged before the failing one remain purged.
+func PurgeVideo(ctx context.Context, store Store, videoID string) ([]string, error) {
+	entry, err := readVideoIndex(ctx, store, videoID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, key := range entry.Keys {
+		if err := store.Purge(ctx, key); err != nil {
+			return entry.Keys, fmt.Errorf("failed to purge %s: %w", key, err)
+		}
+	}
+
+	if err := store.Purge(ctx, videoIndexKey(videoID)); err != nil {
+		return entry.Keys, fmt.Errorf("failed to purge video cache index: %w", err)
+	}
+	return entry.Keys, nil
+}
+
+// readVideoIndex loads videoID's index entry, returning a zero-value entry
+// (not an error) if none exists yet.
+func readVideoIndex(ctx context.Context, store Store, videoID string) (videoIndex, error) {
+	raw, ok, err := store.Get(ctx, videoIndexKey(videoID))
+	if err != nil {
+		return videoIndex{}, fmt.Errorf("failed to read video cache index: %w", err)
+	}
+	if !ok {
+		return videoIndex{}, nil
+	}
+
+	var entry videoIndex
+	if err := json.Unmarshal(raw, &entry); err != nil {
+		return videoIndex{}, fmt.Errorf("failed to decode video cache index: %w", err)
+	}
+	return entry, nil
+}
@@ -0,0 +1,90 @@
+package summarization
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/anthropics/anthropic-sdk-go"
+	"github.com/anthropics/anthropic-sdk-go/option"
+	"github.com/olegshulyakov/go-briefly-bot/briefly"
+)
+
+// anthropicDefaultContextWindow is used when the configured model's context
+// window isn't otherwise known; it matches current Claude models.
+const anthropicDefaultContextWindow = 200_000
+
+// anthropicMaxTokens bounds how many tokens a single summary response may use.
+const anthropicMaxTokens = 1024
+
+// anthropicProvider implements Summarizer against the Anthropic Messages API.
+type anthropicProvider struct {
+	cfg AnthropicConfig
+}
+
+// newAnthropicProvider validates cfg and builds an anthropicProvider.
+func newAnthropicProvider(cfg AnthropicConfig) (*anthropicProvider, error) {
+	if err := cfg.validate(); err != nil {
+		return nil, err
+	}
+	return &anthropicProvider{cfg: cfg}, nil
+}
+
+// Name identifies this provider in logs and in LLM_PROVIDER/LLM_CHAIN_PROVIDERS.
+func (p *anthropicProvider) Name() string {
+	return "anthropic"
+}
+
+// Model returns the configured model identifier.
+func (p *anthropicProvider) Model() string {
+	return p.cfg.Model
+}
+
+// ContextWindow returns the provider's maximum prompt size, in tokens.
+func (p *anthropicProvider) ContextWindow() int {
+	return anthropicDefaultContextWindow
+}
+
+// Pricing returns the provider's approximate per-token cost.
+func (p *anthropicProvider) Pricing() Pricing {
+	return Pricing{InputPerMillionTokens: 3, OutputPerMillionTokens: 15}
+}
+
+// Summarize sends a request to the Anthropic Messages API to summarize text
+// in the specified language.
+//
+// Parameters:
+//   - ctx: Used to cancel the request.
+//   - text: The text to be summarized.
+//   - lang: The language code (e.g., "en", "ru") for localization and summarization.
+//   - promptKey: The i18n key of the prompt template to render (e.g. "llm.prompt").
+//
+// Returns:
+//   - A string containing the summarized text.
+//   - An error if localization or the API request fails.
+func (p *anthropicProvider) Summarize(ctx context.Context, text string, lang string, promptKey string) (string, error) {
+	slog.Debug("anthropicProvider.Summarize start", "language", lang, "prompt", promptKey, "model", p.cfg.Model)
+
+	client := anthropic.NewClient(option.WithAPIKey(p.cfg.APIKey))
+
+	prompt := briefly.MustLocalizeTemplate(lang, promptKey, map[string]string{"text": text})
+
+	message, err := client.Messages.New(ctx, anthropic.MessageNewParams{
+		Model:     anthropic.Model(p.cfg.Model),
+		MaxTokens: anthropicMaxTokens,
+		Messages: []anthropic.MessageParam{
+			anthropic.NewUserMessage(anthropic.NewTextBlock(prompt)),
+		},
+	})
+	if err != nil {
+		slog.Error("Anthropic API error", "error", err)
+		return "", err
+	}
+
+	if len(message.Content) == 0 {
+		slog.Warn("Empty content in Anthropic API response", "message", message)
+		return "", nil
+	}
+
+	slog.Debug("anthropicProvider.Summarize completed", "language", lang, "prompt", promptKey)
+	return message.Content[0].Text, nil
+}
@@ -0,0 +1,123 @@
+package summarization
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+)
+
+// chainProvider implements Summarizer by trying a sequence of other
+// Summarizers in order, falling back to the next one when one fails. It
+// reports the context window and pricing of its first (primary) provider,
+// since that is the one most requests will actually hit.
+type chainProvider struct {
+	providers []Summarizer
+}
+
+// newChainProvider builds the Summarizers listed in cfg.ChainProviders, in
+// order, and wraps them in a chainProvider.
+//
+// Returns:
+//   - A chainProvider trying each configured provider in order.
+//   - An error if cfg.ChainProviders is empty or references an unknown or
+//     misconfigured provider.
+func newChainProvider(cfg *Config) (Summarizer, error) {
+	if len(cfg.ChainProviders) == 0 {
+		return nil, fmt.Errorf("LLM_CHAIN_PROVIDERS not set")
+	}
+
+	chain := &chainProvider{providers: make([]Summarizer, 0, len(cfg.ChainProviders))}
+	for _, name := range cfg.ChainProviders {
+		name = strings.TrimSpace(name)
+		factory, ok := providerFactories[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown provider %q in LLM_CHAIN_PROVIDERS", name)
+		}
+
+		provider, err := factory(cfg)
+		if err != nil {
+			return nil, fmt.Errorf("provider %q: %w", name, err)
+		}
+		chain.providers = append(chain.providers, provider)
+	}
+
+	return chain, nil
+}
+
+// Name identifies this provider in logs.
+func (c *chainProvider) Name() string {
+	return "chain"
+}
+
+// Model returns the first configured provider's model identifier, since
+// that is the one most requests will actually hit. summarizeCached does not
+// use this for cache keys (it keys each attempt by the sub-provider that
+// handled it); this exists only to satisfy Summarizer for callers that just
+// want a representative model name.
+func (c *chainProvider) Model() string {
+	return c.providers[0].Model()
+}
+
+// ContextWindow returns the first configured provider's context window,
+// since chunking must fit the provider requests will land on first.
+func (c *chainProvider) ContextWindow() int {
+	return c.providers[0].ContextWindow()
+}
+
+// Pricing returns the first configured provider's pricing.
+func (c *chainProvider) Pricing() Pricing {
+	return c.providers[0].Pricing()
+}
+
+// Summarize tries each configured provider in order, returning the first
+// successful summary. It does not cache: callers that want caching should
+// use summarizeCached, which keys each attempt by the sub-provider that
+// actually handled it.
+//
+// Returns:
+//   - A string containing the summarized text.
+//   - An error if every configured provider fails.
+func (c *chainProvider) Summarize(ctx context.Context, text string, lang string, promptKey string) (string, error) {
+	var lastErr error
+
+	for _, provider := range c.providers {
+		slog.Debug("Trying chain provider", "provider", provider.Name())
+		summary, err := provider.Summarize(ctx, text, lang, promptKey)
+		if err == nil {
+			return summary, nil
+		}
+
+		slog.Warn("Chain provider failed, trying next", "provider", provider.Name(), "error", err)
+		lastErr = err
+	}
+
+	return "", fmt.Errorf("all chain providers failed: %w", lastErr)
+}
+
+// summarizeCached tries each configured provider in order like Summarize,
+// but routes each attempt through summarizeUsing so the cache entry a
+// fallback produces is keyed by that provider's own Name/Model instead of
+// the chain's primary provider — otherwise a summary generated by, say,
+// ollama after openai failed would be cached as if openai had produced it,
+// and a later successful openai call would wrongly be served it from cache.
+//
+// Returns:
+//   - A string containing the summarized text.
+//   - An error if every configured provider fails.
+func (c *chainProvider) summarizeCached(ctx context.Context, text string, lang string, promptKey string) (string, error) {
+	var lastErr error
+
+	for _, provider := range c.providers {
+		slog.Debug("Trying chain provider", "provider", provider.Name())
+		summary, err := summarizeUsing(ctx, provider, text, lang, promptKey)
+		if err == nil {
+			return summary, nil
+		}
+
+		slog.Warn("Chain provider failed, trying next", "provider", provider.Name(), "error", err)
+		lastErr = err
+	}
+
+	return "", fmt.Errorf("all chain providers failed: %w", lastErr)
+}
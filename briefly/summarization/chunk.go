@@ -0,0 +1,211 @@
+package summarization
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+)
+
+// defaultChunkTokens is the default chunk size, in approximate tokens, used
+// when splitting a transcript that is too large for a single prompt.
+const defaultChunkTokens = 3000
+
+// defaultChunkOverlap is the default number of tokens repeated between two
+// consecutive chunks so that context is not lost at chunk boundaries.
+const defaultChunkOverlap = 200
+
+// defaultMaxConcurrency bounds how many chunks are summarized at the same time.
+const defaultMaxConcurrency = 4
+
+// chunkContextWindowDivisor reserves headroom in the provider's context
+// window for the prompt template and the model's response when sizing
+// chunks automatically from Summarizer.ContextWindow().
+const chunkContextWindowDivisor = 4
+
+// LongOptions configures SummarizeLong's chunking and concurrency behavior.
+// A zero value falls back to the package defaults.
+type LongOptions struct {
+	// ChunkTokens is the approximate number of tokens per chunk.
+	ChunkTokens int
+	// ChunkOverlap is the approximate number of tokens shared between
+	// consecutive chunks.
+	ChunkOverlap int
+	// MaxConcurrency bounds how many chunks are summarized at once.
+	MaxConcurrency int
+}
+
+// withDefaults fills in zero-valued fields with package defaults, sizing
+// ChunkTokens off the configured Summarizer's context window when available
+// so chunking automatically adapts to the selected provider.
+func (o LongOptions) withDefaults() LongOptions {
+	if o.ChunkTokens <= 0 {
+		if summarizer, err := defaultSummarizer(); err == nil {
+			o.ChunkTokens = summarizer.ContextWindow() / chunkContextWindowDivisor
+		} else {
+			o.ChunkTokens = defaultChunkTokens
+		}
+	}
+	if o.ChunkOverlap <= 0 || o.ChunkOverlap >= o.ChunkTokens {
+		o.ChunkOverlap = defaultChunkOverlap
+	}
+	if o.MaxConcurrency <= 0 {
+		o.MaxConcurrency = defaultMaxConcurrency
+	}
+	return o
+}
+
+// SummarizeLong summarizes transcripts that are too large to fit in a single
+// prompt using a map-reduce strategy.
+//
+// The function performs the following steps:
+//  1. Splits text into overlapping, token-aware chunks (see chunkText).
+//  2. Summarizes every chunk concurrently through a bounded worker pool,
+//     using the localized "llm.prompt.map" template.
+//  3. Concatenates the chunk summaries and summarizes the result again using
+//     the localized "llm.prompt.reduce" template, repeating the reduce step
+//     until the combined summary fits in a single chunk.
+//
+// Parameters:
+//   - ctx: Used to cancel in-flight chunk requests.
+//   - text: The text to be summarized.
+//   - lang: The language code (e.g., "en", "ru") for localization and summarization.
+//   - opts: Chunking and concurrency tuning; zero value uses package defaults.
+//
+// Returns:
+//   - A string containing the summarized text.
+//   - An error if chunking or any map/reduce request fails.
+//
+// Example:
+//
+//	summary, err := summarization.SummarizeLong(ctx, longTranscript, "en", summarization.LongOptions{})
+//	if err != nil {
+//	    log.Errorf("Failed to summarize long text: %v", err)
+//	}
+func SummarizeLong(ctx context.Context, text string, lang string, opts LongOptions) (string, error) {
+	opts = opts.withDefaults()
+
+	chunks := chunkText(text, opts.ChunkTokens, opts.ChunkOverlap)
+	if len(chunks) <= 1 {
+		return summarizeWithPrompt(ctx, text, lang, "llm.prompt")
+	}
+
+	slog.Debug("SummarizeLong start", "language", lang, "chunks", len(chunks))
+
+	summaries, err := mapSummarize(ctx, chunks, lang, "llm.prompt.map", opts.MaxConcurrency)
+	if err != nil {
+		return "", fmt.Errorf("failed to summarize chunks: %w", err)
+	}
+
+	combined := strings.Join(summaries, "\n\n")
+	for countTokens(combined) > opts.ChunkTokens {
+		slog.Debug("SummarizeLong reduce pass", "language", lang, "tokens", countTokens(combined))
+
+		reduced, err := reduceSummarize(ctx, combined, lang, opts)
+		if err != nil {
+			return "", fmt.Errorf("failed to reduce summaries: %w", err)
+		}
+		combined = reduced
+	}
+
+	slog.Debug("SummarizeLong completed", "language", lang)
+	return summarizeWithPrompt(ctx, combined, lang, "llm.prompt.reduce")
+}
+
+// mapSummarize summarizes every chunk concurrently through a worker pool
+// bounded by maxConcurrency, preserving the original chunk order in the
+// result. promptKey selects the localized template each chunk is summarized
+// with, so reduceSummarize can reuse this helper with "llm.prompt.reduce"
+// instead of the map pass's "llm.prompt.map".
+func mapSummarize(ctx context.Context, chunks []string, lang string, promptKey string, maxConcurrency int) ([]string, error) {
+	var (
+		wg        sync.WaitGroup
+		sem       = make(chan struct{}, maxConcurrency)
+		summaries = make([]string, len(chunks))
+		errs      = make([]error, len(chunks))
+	)
+
+	for i, chunk := range chunks {
+		wg.Add(1)
+		go func(i int, chunk string) {
+			defer wg.Done()
+
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				errs[i] = ctx.Err()
+				return
+			}
+			defer func() { <-sem }()
+
+			summary, err := summarizeWithPrompt(ctx, chunk, lang, promptKey)
+			if err != nil {
+				errs[i] = err
+				return
+			}
+			summaries[i] = summary
+		}(i, chunk)
+	}
+	wg.Wait()
+
+	for i, err := range errs {
+		if err != nil {
+			return nil, fmt.Errorf("chunk %d: %w", i, err)
+		}
+	}
+
+	return summaries, nil
+}
+
+// reduceSummarize splits an oversized concatenation of chunk summaries back
+// into chunks and summarizes each one with the "llm.prompt.reduce" template,
+// so the recursive reduce pass in SummarizeLong keeps shrinking the result
+// by a bounded factor every round without falling back to the map prompt.
+func reduceSummarize(ctx context.Context, text string, lang string, opts LongOptions) (string, error) {
+	chunks := chunkText(text, opts.ChunkTokens, opts.ChunkOverlap)
+	summaries, err := mapSummarize(ctx, chunks, lang, "llm.prompt.reduce", opts.MaxConcurrency)
+	if err != nil {
+		return "", err
+	}
+	return strings.Join(summaries, "\n\n"), nil
+}
+
+// chunkText splits text into overlapping, token-aware chunks of roughly
+// chunkTokens tokens each, with overlapTokens tokens repeated between
+// consecutive chunks so summaries don't lose context at the boundary.
+//
+// Tokens are approximated by whitespace-delimited words, which is close
+// enough for sizing chunks relative to a model's context window without
+// depending on a model-specific tokenizer.
+func chunkText(text string, chunkTokens int, overlapTokens int) []string {
+	words := strings.Fields(text)
+	if len(words) == 0 {
+		return nil
+	}
+
+	step := chunkTokens - overlapTokens
+	if step <= 0 {
+		step = chunkTokens
+	}
+
+	var chunks []string
+	for start := 0; start < len(words); start += step {
+		end := start + chunkTokens
+		if end > len(words) {
+			end = len(words)
+		}
+		chunks = append(chunks, strings.Join(words[start:end], " "))
+		if end == len(words) {
+			break
+		}
+	}
+
+	return chunks
+}
+
+// countTokens approximates the number of tokens in text by counting
+// whitespace-delimited words, matching the approximation used by chunkText.
+func countTokens(text string) int {
+	return len(strings.Fields(text))
+}
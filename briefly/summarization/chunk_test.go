@@ -0,0 +1,72 @@
+package summarization
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestChunkTextEmpty(t *testing.T) {
+	if chunks := chunkText("", 10, 2); chunks != nil {
+		t.Fatalf("chunkText(\"\", ...) = %v, want nil", chunks)
+	}
+	if chunks := chunkText("   \t\n", 10, 2); chunks != nil {
+		t.Fatalf("chunkText(whitespace-only, ...) = %v, want nil", chunks)
+	}
+}
+
+func TestChunkTextSingleChunk(t *testing.T) {
+	text := "one two three"
+	chunks := chunkText(text, 10, 2)
+	if len(chunks) != 1 || chunks[0] != text {
+		t.Fatalf("chunkText(%q, 10, 2) = %v, want single chunk %q", text, chunks, text)
+	}
+}
+
+func TestChunkTextOverlap(t *testing.T) {
+	words := make([]string, 10)
+	for i := range words {
+		words[i] = strings.Repeat("w", 1) + string(rune('a'+i))
+	}
+	text := strings.Join(words, " ")
+
+	chunks := chunkText(text, 4, 1)
+	if len(chunks) < 2 {
+		t.Fatalf("chunkText(%q, 4, 1) = %v, want at least 2 chunks", text, chunks)
+	}
+
+	last := strings.Fields(chunks[len(chunks)-1])
+	if last[len(last)-1] != words[len(words)-1] {
+		t.Fatalf("last chunk %v does not end with the final word %q", chunks[len(chunks)-1], words[len(words)-1])
+	}
+}
+
+// TestChunkTextOverlapAtLeastChunkTokens covers the case where the caller's
+// overlap would never let the window advance; chunkText must still make
+// progress instead of looping forever.
+func TestChunkTextOverlapAtLeastChunkTokens(t *testing.T) {
+	text := "one two three four five six"
+	chunks := chunkText(text, 3, 3)
+	if len(chunks) == 0 {
+		t.Fatalf("chunkText(%q, 3, 3) returned no chunks", text)
+	}
+
+	joined := strings.Join(chunks, " ")
+	if !strings.Contains(joined, "six") {
+		t.Fatalf("chunkText(%q, 3, 3) = %v, never reached the end of the text", text, chunks)
+	}
+}
+
+func TestCountTokens(t *testing.T) {
+	cases := map[string]int{
+		"":                0,
+		"   ":             0,
+		"one":             1,
+		"one two":         2,
+		"one  two\nthree": 3,
+	}
+	for text, want := range cases {
+		if got := countTokens(text); got != want {
+			t.Errorf("countTokens(%q) = %d, want %d", text, got, want)
+		}
+	}
+}
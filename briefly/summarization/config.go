@@ -0,0 +1,156 @@
+package summarization
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+// Config holds every provider's settings, loaded from the environment by
+// LoadConfig. Only the fields needed by Provider (and, for the "chain"
+// provider, ChainProviders) are validated; unrelated provider sections may be
+// left empty.
+type Config struct {
+	// Provider selects which Summarizer LoadConfig builds, via LLM_PROVIDER.
+	// One of "openai", "ollama", "anthropic", or "chain".
+	Provider string
+
+	// ChainProviders lists the providers the "chain" provider tries in
+	// order, via LLM_CHAIN_PROVIDERS (comma-separated, e.g. "openai,ollama").
+	ChainProviders []string
+
+	OpenAI    OpenAIConfig
+	Ollama    OllamaConfig
+	Anthropic AnthropicConfig
+}
+
+// OpenAIConfig holds settings for the OpenAI-compatible provider, read from
+// OPENAI_BASE_URL, OPENAI_API_KEY, and OPENAI_MODEL.
+type OpenAIConfig struct {
+	BaseURL string
+	APIKey  string
+	Model   string
+}
+
+// OllamaConfig holds settings for the native Ollama provider, read from
+// OLLAMA_BASE_URL and OLLAMA_MODEL.
+type OllamaConfig struct {
+	BaseURL string
+	Model   string
+}
+
+// AnthropicConfig holds settings for the Anthropic Messages API provider,
+// read from ANTHROPIC_API_KEY and ANTHROPIC_MODEL.
+type AnthropicConfig struct {
+	APIKey string
+	Model  string
+}
+
+// LoadConfig reads the LLM provider configuration from the environment and
+// validates the section relevant to the selected provider.
+//
+// LoadConfig replaces the package's former init()-time validation, which
+// called os.Exit on a misconfiguration. That made it impossible to construct
+// a Summarizer in tests or in a multi-tenant deployment where configuration
+// comes from somewhere other than process-wide environment variables.
+// Callers that do want fail-fast startup behavior can still call LoadConfig
+// from their own main() and exit on error.
+//
+// Returns:
+//   - A Config populated from the environment.
+//   - An error describing which required variable is missing for the
+//     selected LLM_PROVIDER.
+func LoadConfig() (*Config, error) {
+	cfg := &Config{
+		Provider: os.Getenv("LLM_PROVIDER"),
+		OpenAI: OpenAIConfig{
+			BaseURL: os.Getenv("OPENAI_BASE_URL"),
+			APIKey:  os.Getenv("OPENAI_API_KEY"),
+			Model:   os.Getenv("OPENAI_MODEL"),
+		},
+		Ollama: OllamaConfig{
+			BaseURL: os.Getenv("OLLAMA_BASE_URL"),
+			Model:   os.Getenv("OLLAMA_MODEL"),
+		},
+		Anthropic: AnthropicConfig{
+			APIKey: os.Getenv("ANTHROPIC_API_KEY"),
+			Model:  os.Getenv("ANTHROPIC_MODEL"),
+		},
+	}
+
+	if cfg.Provider == "" {
+		cfg.Provider = "openai"
+	}
+	if chain := os.Getenv("LLM_CHAIN_PROVIDERS"); chain != "" {
+		cfg.ChainProviders = strings.Split(chain, ",")
+	}
+
+	if err := cfg.validate(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// validate checks that the section needed by cfg.Provider is fully populated.
+func (cfg *Config) validate() error {
+	switch cfg.Provider {
+	case "openai":
+		return cfg.OpenAI.validate()
+	case "ollama":
+		return cfg.Ollama.validate()
+	case "anthropic":
+		return cfg.Anthropic.validate()
+	case "chain":
+		if len(cfg.ChainProviders) == 0 {
+			return fmt.Errorf("LLM_CHAIN_PROVIDERS not set")
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown LLM_PROVIDER %q", cfg.Provider)
+	}
+}
+
+func (c OpenAIConfig) validate() error {
+	var missing []string
+	if c.BaseURL == "" {
+		missing = append(missing, "OPENAI_BASE_URL")
+	}
+	if c.APIKey == "" {
+		missing = append(missing, "OPENAI_API_KEY")
+	}
+	if c.Model == "" {
+		missing = append(missing, "OPENAI_MODEL")
+	}
+	return missingErr(missing)
+}
+
+func (c OllamaConfig) validate() error {
+	var missing []string
+	if c.BaseURL == "" {
+		missing = append(missing, "OLLAMA_BASE_URL")
+	}
+	if c.Model == "" {
+		missing = append(missing, "OLLAMA_MODEL")
+	}
+	return missingErr(missing)
+}
+
+func (c AnthropicConfig) validate() error {
+	var missing []string
+	if c.APIKey == "" {
+		missing = append(missing, "ANTHROPIC_API_KEY")
+	}
+	if c.Model == "" {
+		missing = append(missing, "ANTHROPIC_MODEL")
+	}
+	return missingErr(missing)
+}
+
+// missingErr builds an error listing missing environment variables, or nil
+// if missing is empty.
+func missingErr(missing []string) error {
+	if len(missing) == 0 {
+		return nil
+	}
+	return fmt.Errorf("missing required environment variables: %s", strings.Join(missing, ", "))
+}
@@ -0,0 +1,130 @@
+package summarization
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/olegshulyakov/go-briefly-bot/briefly"
+)
+
+// ollamaDefaultContextWindow is used when the configured model's context
+// window isn't otherwise known; it matches Ollama's common default for
+// Llama-class models.
+const ollamaDefaultContextWindow = 8_192
+
+// ollamaProvider implements Summarizer against a native Ollama server's
+// `/api/generate` endpoint, streaming the response instead of going through
+// Ollama's OpenAI-compatibility shim.
+type ollamaProvider struct {
+	cfg        OllamaConfig
+	httpClient *http.Client
+}
+
+// newOllamaProvider validates cfg and builds an ollamaProvider.
+func newOllamaProvider(cfg OllamaConfig) (*ollamaProvider, error) {
+	if err := cfg.validate(); err != nil {
+		return nil, err
+	}
+	return &ollamaProvider{cfg: cfg, httpClient: &http.Client{Timeout: maxTimeout}}, nil
+}
+
+// Name identifies this provider in logs and in LLM_PROVIDER/LLM_CHAIN_PROVIDERS.
+func (p *ollamaProvider) Name() string {
+	return "ollama"
+}
+
+// Model returns the configured model identifier.
+func (p *ollamaProvider) Model() string {
+	return p.cfg.Model
+}
+
+// ContextWindow returns the provider's maximum prompt size, in tokens.
+func (p *ollamaProvider) ContextWindow() int {
+	return ollamaDefaultContextWindow
+}
+
+// Pricing returns the provider's approximate per-token cost. Ollama runs
+// locally, so there is no per-token charge.
+func (p *ollamaProvider) Pricing() Pricing {
+	return Pricing{}
+}
+
+// ollamaGenerateRequest is the request body for Ollama's `/api/generate` endpoint.
+type ollamaGenerateRequest struct {
+	Model  string `json:"model"`
+	Prompt string `json:"prompt"`
+	Stream bool   `json:"stream"`
+}
+
+// ollamaGenerateChunk is one line of Ollama's newline-delimited JSON stream.
+type ollamaGenerateChunk struct {
+	Response string `json:"response"`
+	Done     bool   `json:"done"`
+}
+
+// Summarize sends a request to the configured Ollama server to summarize
+// text in the specified language, accumulating the streamed response.
+//
+// Parameters:
+//   - ctx: Used to cancel the request.
+//   - text: The text to be summarized.
+//   - lang: The language code (e.g., "en", "ru") for localization and summarization.
+//   - promptKey: The i18n key of the prompt template to render (e.g. "llm.prompt").
+//
+// Returns:
+//   - A string containing the summarized text.
+//   - An error if localization, the HTTP request, or the streamed response fails.
+func (p *ollamaProvider) Summarize(ctx context.Context, text string, lang string, promptKey string) (string, error) {
+	slog.Debug("ollamaProvider.Summarize start", "language", lang, "prompt", promptKey, "api", p.cfg.BaseURL, "model", p.cfg.Model)
+
+	prompt := briefly.MustLocalizeTemplate(lang, promptKey, map[string]string{"text": text})
+
+	reqBody, err := json.Marshal(ollamaGenerateRequest{
+		Model:  p.cfg.Model,
+		Prompt: prompt,
+		Stream: true,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to encode Ollama request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, strings.TrimRight(p.cfg.BaseURL, "/")+"/api/generate", bytes.NewReader(reqBody))
+	if err != nil {
+		return "", fmt.Errorf("failed to build Ollama request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		slog.Error("Ollama API error", "error", err)
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return "", fmt.Errorf("ollama API returned status %s: %s", resp.Status, body)
+	}
+
+	var summary strings.Builder
+	decoder := json.NewDecoder(resp.Body)
+	for decoder.More() {
+		var chunk ollamaGenerateChunk
+		if err := decoder.Decode(&chunk); err != nil {
+			return "", fmt.Errorf("failed to decode Ollama stream: %w", err)
+		}
+		summary.WriteString(chunk.Response)
+		if chunk.Done {
+			break
+		}
+	}
+
+	slog.Debug("ollamaProvider.Summarize completed", "language", lang, "prompt", promptKey)
+	return summary.String(), nil
+}
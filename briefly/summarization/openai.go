@@ -4,10 +4,10 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
-	"os"
 	"time"
 
 	"github.com/olegshulyakov/go-briefly-bot/briefly"
+	"github.com/olegshulyakov/go-briefly-bot/briefly/cache"
 	"github.com/openai/openai-go"
 	"github.com/openai/openai-go/option"
 )
@@ -18,72 +18,76 @@ const maxRetries = 3
 // maxTimeout sets maximun request timeout
 const maxTimeout = 20 * time.Second
 
-var (
-	openAiBaseURL string
-	openAiAPIKey  string
-	openAiModel   string
-)
+// openAIDefaultContextWindow is used when the configured model's context
+// window isn't otherwise known; it matches common gpt-4o-class models.
+const openAIDefaultContextWindow = 128_000
 
-func init() {
-	openAiBaseURL = os.Getenv("OPENAI_BASE_URL")
-	openAiAPIKey = os.Getenv("OPENAI_API_KEY")
-	openAiModel = os.Getenv("OPENAI_MODEL")
+// openAIProvider implements Summarizer against any OpenAI-compatible chat
+// completions endpoint (OpenAI itself, or a compatible gateway such as a
+// local Ollama instance's OpenAI shim).
+type openAIProvider struct {
+	cfg OpenAIConfig
+}
 
-	// Validate provider-specific fields
-	isError := false
-	if openAiBaseURL == "" {
-		fmt.Fprintf(os.Stderr, "OPENAI_BASE_URL not set")
-		isError = true
-	}
-	if openAiAPIKey == "" {
-		fmt.Fprintf(os.Stderr, "OPENAI_API_KEY not set")
-		isError = true
-	}
-	if openAiModel == "" {
-		fmt.Fprintf(os.Stderr, "OPENAI_MODEL not set")
-		isError = true
+// newOpenAIProvider validates cfg and builds an openAIProvider. Unlike the
+// package's former init()-time check, a misconfiguration is returned as an
+// error rather than calling os.Exit, so callers (including tests) can
+// construct a Summarizer without exiting the process.
+func newOpenAIProvider(cfg OpenAIConfig) (*openAIProvider, error) {
+	if err := cfg.validate(); err != nil {
+		return nil, err
 	}
+	return &openAIProvider{cfg: cfg}, nil
+}
 
-	if isError {
-		os.Exit(1)
-	}
+// Name identifies this provider in logs and in LLM_PROVIDER/LLM_CHAIN_PROVIDERS.
+func (p *openAIProvider) Name() string {
+	return "openai"
+}
+
+// Model returns the configured model identifier.
+func (p *openAIProvider) Model() string {
+	return p.cfg.Model
 }
 
-// SummarizeText sends a request to a configured Language Model (LLM) provider
-// (e.g., OpenAI or Ollama) to summarize the given text in the specified language.
+// ContextWindow returns the provider's maximum prompt size, in tokens.
+func (p *openAIProvider) ContextWindow() int {
+	return openAIDefaultContextWindow
+}
+
+// Pricing returns the provider's approximate per-token cost. OpenAI-compatible
+// endpoints vary widely in pricing (including self-hosted gateways that are
+// free), so this is a conservative placeholder rather than a live price feed.
+func (p *openAIProvider) Pricing() Pricing {
+	return Pricing{InputPerMillionTokens: 5, OutputPerMillionTokens: 15}
+}
+
+// Summarize sends a request to the configured OpenAI-compatible endpoint to
+// summarize text in the specified language.
 //
 // The function performs the following steps:
-//  1. Loads the application configuration to determine the LLM provider and its settings.
-//  2. Localizes the system and user prompts based on the specified language.
-//  3. Prepares and sends an HTTP request to the LLM provider's API.
-//  4. Decodes the API response and extracts the summarized text.
+//  1. Localizes the prompt template at promptKey based on lang.
+//  2. Prepares and sends a chat completion request to the endpoint.
+//  3. Decodes the response and extracts the summarized text.
 //
 // Parameters:
+//   - ctx: Used to cancel the request and enforce maxTimeout.
 //   - text: The text to be summarized.
 //   - lang: The language code (e.g., "en", "ru") for localization and summarization.
+//   - promptKey: The i18n key of the prompt template to render (e.g. "llm.prompt").
 //
 // Returns:
 //   - A string containing the summarized text.
-//   - An error if any step fails, such as configuration loading, API request, or response decoding.
-//
-// Example:
-//
-//	summary, err := SummarizeText("This is a long text to summarize.", "en")
-//	if err != nil {
-//	    log.Errorf("Failed to summarize text: %v", err)
-//	}
-//	fmt.Println("Summary:", summary)
+//   - An error if localization, the API request, or response decoding fails.
 //
 // Notes:
-//   - The function relies on the application configuration (`LoadConfig`) to determine
-//     the LLM provider (e.g., OpenAI or Ollama) and its settings (e.g., API URL, token, model).
 //   - The API response is expected to contain a "choices" field with the summarized text.
-func SummarizeText(text string, lang string) (string, error) {
-	slog.Debug("SummarizeText start", "language", lang, "api", openAiBaseURL, "model", openAiModel)
+func (p *openAIProvider) Summarize(ctx context.Context, text string, lang string, promptKey string) (string, error) {
+	slog.Debug("openAIProvider.Summarize start", "language", lang, "prompt", promptKey, "api", p.cfg.BaseURL, "model", p.cfg.Model)
 
 	client := openai.NewClient(
-		option.WithBaseURL(openAiBaseURL),
-		option.WithAPIKey(openAiAPIKey),
+		option.WithBaseURL(p.cfg.BaseURL),
+		option.WithAPIKey(p.cfg.APIKey),
 	)
 
 	// Localize system and user prompts
@@ -92,18 +96,18 @@ func SummarizeText(text string, lang string) (string, error) {
 		Messages: []openai.ChatCompletionMessageParamUnion{
 			openai.UserMessage(briefly.MustLocalizeTemplate(
 				lang,
-				"llm.prompt",
+				promptKey,
 				map[string]string{
 					"text": text,
 				},
 			)),
 		},
-		Model: openAiModel,
+		Model: p.cfg.Model,
 	}
 
 	slog.Debug("Summarizing text...")
 	chatCompletion, err := client.Chat.Completions.New(
-		context.Background(),
+		ctx,
 		body,
 		option.WithRequestTimeout(maxTimeout),
 		option.WithMaxRetries(maxRetries),
@@ -119,12 +123,116 @@ func SummarizeText(text string, lang string) (string, error) {
 	slog.Debug("Extracting summary from response...")
 	choices := chatCompletion.Choices
 	if len(choices) == 0 {
-		slog.Warn("Invalid or empty choices in API response, retrying...", "chatCompletion", chatCompletion)
+		slog.Warn("Invalid or empty choices in API response", "chatCompletion", chatCompletion)
 		return "", err
 	}
 
 	summary := choices[0].Message.Content
 
-	slog.Debug("SummarizeText completed", "language", lang)
+	slog.Debug("openAIProvider.Summarize completed", "language", lang, "prompt", promptKey)
+	return summary, nil
+}
+
+// SummarizeText sends a request to the configured Language Model (LLM)
+// provider (selected via LLM_PROVIDER) to summarize the given text in the
+// specified language.
+//
+// Parameters:
+//   - text: The text to be summarized.
+//   - lang: The language code (e.g., "en", "ru") for localization and summarization.
+//
+// Returns:
+//   - A string containing the summarized text.
+//   - An error if any step fails, such as configuration loading, API request, or response decoding.
+//
+// Example:
+//
+//	summary, err := SummarizeText("This is a long text to summarize.", "en")
+//	if err != nil {
+//	    log.Errorf("Failed to summarize text: %v", err)
+//	}
+//	fmt.Println("Summary:", summary)
+//
+// Notes:
+//   - The function relies on LoadConfig to determine the LLM provider
+//     (e.g., OpenAI, Ollama, or Anthropic) and its settings.
+func SummarizeText(text string, lang string) (string, error) {
+	return summarizeWithPrompt(context.Background(), text, lang, "llm.prompt")
+}
+
+// summaryCacheTTL bounds how long a cached summary is served before
+// summarizeWithPrompt re-invokes the LLM.
+const summaryCacheTTL = 30 * 24 * time.Hour
+
+// summaryCacheStore returns the process-wide cache (shared with the
+// transcript cache, so a CACHE_BACKEND=bolt deployment opens its database
+// file once, not once per cache user).
+func summaryCacheStore() cache.Store {
+	return cache.Default()
+}
+
+// summarizeWithPrompt is the shared implementation behind SummarizeText and
+// the map/reduce stages of SummarizeLong. promptKey selects which localized
+// template is rendered (e.g. "llm.prompt", "llm.prompt.map", "llm.prompt.reduce"),
+// so callers can tune the map/reduce behavior via new i18n keys without
+// duplicating the request/response plumbing below.
+//
+// A *chainProvider delegates to its own summarizeCached instead of going
+// through summarizeUsing directly here, so each fallback attempt is cached
+// under the sub-provider that actually produced it rather than the chain's
+// primary provider.
+func summarizeWithPrompt(ctx context.Context, text string, lang string, promptKey string) (string, error) {
+	summarizer, err := defaultSummarizer()
+	if err != nil {
+		return "", err
+	}
+
+	if chain, ok := summarizer.(*chainProvider); ok {
+		return chain.summarizeCached(ctx, text, lang, promptKey)
+	}
+	return summarizeUsing(ctx, summarizer, text, lang, promptKey)
+}
+
+// summarizeUsing runs a single Summarizer, checking and populating the
+// summary cache keyed by that summarizer's own Name/Model so a fallback
+// provider's result is never mislabeled as having come from another one.
+// Results are cached by the SHA-256 of text, the provider/prompt pair, and
+// lang.
+func summarizeUsing(ctx context.Context, summarizer Summarizer, text string, lang string, promptKey string) (string, error) {
+	store := summaryCacheStore()
+	// Fold promptKey into the prompt half of the key (distinct templates for
+	// the same text must not collide) and the provider's actual configured
+	// model into the model half, so switching OPENAI_MODEL (or equivalent)
+	// invalidates previously cached summaries instead of serving stale ones.
+	cacheKey := cache.SummaryKey(promptKey+"\x00"+text, fmt.Sprintf("%s:%s", summarizer.Name(), summarizer.Model()), lang)
+
+	if store != nil {
+		if cached, ok, err := store.Get(ctx, cacheKey); err != nil {
+			slog.Warn("Summary cache read failed, summarizing fresh", "error", err)
+		} else if ok {
+			slog.Debug("Summary cache hit", "provider", summarizer.Name(), "prompt", promptKey, "language", lang)
+			return string(cached), nil
+		}
+	}
+
+	summary, err := summarizer.Summarize(ctx, text, lang, promptKey)
+	if err != nil {
+		return "", err
+	}
+
+	if store != nil {
+		if err := store.Put(ctx, cacheKey, []byte(summary), summaryCacheTTL); err != nil {
+			slog.Warn("Failed to cache summary", "error", err)
+		} else if videoID, ok := cache.VideoIDFromContext(ctx); ok {
+			// Only populated when the caller attached one via cache.WithVideoID
+			// (SummarizeText's context.Background() never does), so that
+			// `cache purge <videoID>` can also remove summaries derived from
+			// this video's transcript.
+			if err := cache.IndexForVideo(ctx, store, videoID, cacheKey); err != nil {
+				slog.Warn("Failed to index summary cache entry for purge", "error", err)
+			}
+		}
+	}
+
 	return summary, nil
 }
@@ -0,0 +1,92 @@
+package summarization
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// Pricing describes a Summarizer's approximate per-token cost, in USD per
+// million tokens. It is informational: it lets callers (and the chunked
+// map-reduce summarizer) reason about cost and context budget without
+// hard-coding provider-specific numbers.
+type Pricing struct {
+	InputPerMillionTokens  float64
+	OutputPerMillionTokens float64
+}
+
+// Summarizer sends a single summarization request to a Language Model
+// provider. Implementations are registered in providerFactories and selected
+// by Config.Provider via NewSummarizer.
+type Summarizer interface {
+	// Name identifies the provider in logs and in LLM_PROVIDER/LLM_CHAIN_PROVIDERS.
+	Name() string
+	// Model returns the configured model identifier (e.g. "gpt-4o",
+	// "llama3", "claude-sonnet-4-5"), used to key the summary cache so
+	// changing the configured model invalidates previously cached summaries.
+	Model() string
+	// ContextWindow returns the provider's maximum prompt size, in tokens.
+	// SummarizeLong uses it to size chunks automatically.
+	ContextWindow() int
+	// Pricing returns the provider's approximate per-token cost.
+	Pricing() Pricing
+	// Summarize renders the localized template at promptKey with text and
+	// sends it to the provider, returning the model's response.
+	Summarize(ctx context.Context, text string, lang string, promptKey string) (string, error)
+}
+
+// providerFactories maps a provider name (as used in LLM_PROVIDER) to a
+// constructor. The "chain" provider is assembled separately by
+// NewSummarizer, since it depends on other entries in this map.
+var providerFactories = map[string]func(*Config) (Summarizer, error){
+	"openai": func(cfg *Config) (Summarizer, error) {
+		return newOpenAIProvider(cfg.OpenAI)
+	},
+	"ollama": func(cfg *Config) (Summarizer, error) {
+		return newOllamaProvider(cfg.Ollama)
+	},
+	"anthropic": func(cfg *Config) (Summarizer, error) {
+		return newAnthropicProvider(cfg.Anthropic)
+	},
+}
+
+// NewSummarizer builds the Summarizer selected by cfg.Provider.
+//
+// Returns:
+//   - A Summarizer ready to use.
+//   - An error if cfg.Provider is unknown or the selected provider's
+//     constructor fails (e.g. the chain references an unknown provider).
+func NewSummarizer(cfg *Config) (Summarizer, error) {
+	if cfg.Provider == "chain" {
+		return newChainProvider(cfg)
+	}
+
+	factory, ok := providerFactories[cfg.Provider]
+	if !ok {
+		return nil, fmt.Errorf("unknown LLM provider %q", cfg.Provider)
+	}
+	return factory(cfg)
+}
+
+// defaultSummarizer is the process-wide Summarizer used by SummarizeText and
+// SummarizeLong, built lazily from LoadConfig so importing this package no
+// longer requires every environment variable to be set up front.
+var (
+	defaultSummarizerOnce sync.Once
+	defaultSummarizerVal  Summarizer
+	defaultSummarizerErr  error
+)
+
+// defaultSummarizer returns the process-wide Summarizer, building it from
+// LoadConfig on first use.
+func defaultSummarizer() (Summarizer, error) {
+	defaultSummarizerOnce.Do(func() {
+		cfg, err := LoadConfig()
+		if err != nil {
+			defaultSummarizerErr = fmt.Errorf("failed to load summarization config: %w", err)
+			return
+		}
+		defaultSummarizerVal, defaultSummarizerErr = NewSummarizer(cfg)
+	})
+	return defaultSummarizerVal, defaultSummarizerErr
+}
@@ -0,0 +1,124 @@
+// Package utils holds transcript post-processing helpers shared by the
+// youtube provider implementations: decoding the SRT format yt-dlp emits,
+// and cleaning the cue text each provider ends up with into plain text
+// suitable for summarization.
+package utils
+
+import (
+	"bufio"
+	"io"
+	"regexp"
+	"strings"
+)
+
+// timestampPattern matches an SRT cue timing line, e.g.
+// "00:00:01,000 --> 00:00:04,000".
+var timestampPattern = regexp.MustCompile(`^\d{2}:\d{2}:\d{2}[,.]\d{3}\s*-->\s*\d{2}:\d{2}:\d{2}[,.]\d{3}`)
+
+// indexPattern matches an SRT cue index line, e.g. "42".
+var indexPattern = regexp.MustCompile(`^\d+$`)
+
+// tagPattern strips simple markup tags (e.g. "<i>", "</b>") that some
+// auto-generated tracks embed in cue text.
+var tagPattern = regexp.MustCompile(`</?[a-zA-Z][^>]*>`)
+
+// DecodeSRT reads an SRT document from r and returns its cue text joined
+// into a single string, one cue per line. It scans line by line via
+// bufio.Scanner rather than buffering the whole document up front, so it
+// can be handed a pipe still being filled by a running `yt-dlp` process.
+//
+// Parameters:
+//   - r: The SRT document to decode.
+//
+// Returns:
+//   - The cue text, in order, one cue per line.
+//   - An error if the scanner fails to read from r.
+//
+// Notes:
+//   - Index lines and timestamp lines are discarded; only cue text remains.
+func DecodeSRT(r io.Reader) (string, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	var lines []string
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || indexPattern.MatchString(line) || timestampPattern.MatchString(line) {
+			continue
+		}
+		lines = append(lines, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return "", err
+	}
+
+	return strings.Join(lines, "\n"), nil
+}
+
+// CleanSRT normalizes SRT-derived cue text into plain transcript text. It
+// accepts either a raw SRT document or text already decoded by DecodeSRT:
+// index and timestamp lines are discarded the same way, and consecutive
+// duplicate lines are collapsed, since auto-generated tracks commonly repeat
+// the previous line's text while a caption is still rolling into view.
+//
+// Parameters:
+//   - text: The SRT document or decoded cue text to clean.
+//
+// Returns:
+//   - The cleaned transcript text.
+//   - An error if text cannot be scanned.
+func CleanSRT(text string) (string, error) {
+	scanner := bufio.NewScanner(strings.NewReader(text))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	var lines []string
+	var prev string
+	for scanner.Scan() {
+		line := strings.TrimSpace(tagPattern.ReplaceAllString(scanner.Text(), ""))
+		if line == "" || indexPattern.MatchString(line) || timestampPattern.MatchString(line) {
+			continue
+		}
+		if line == prev {
+			continue
+		}
+		lines = append(lines, line)
+		prev = line
+	}
+	if err := scanner.Err(); err != nil {
+		return "", err
+	}
+
+	return strings.Join(lines, "\n"), nil
+}
+
+// CleanText normalizes plain (non-SRT) transcript text extracted from a
+// source that has no cue indices or timestamps of its own, such as
+// YouTube's `timedtext` XML endpoint: it trims each line, drops blank
+// lines, and collapses consecutive duplicate lines.
+//
+// Parameters:
+//   - text: The plain transcript text to clean.
+//
+// Returns:
+//   - The cleaned transcript text.
+//   - An error if text cannot be scanned.
+func CleanText(text string) (string, error) {
+	scanner := bufio.NewScanner(strings.NewReader(text))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	var lines []string
+	var prev string
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || line == prev {
+			continue
+		}
+		lines = append(lines, line)
+		prev = line
+	}
+	if err := scanner.Err(); err != nil {
+		return "", err
+	}
+
+	return strings.Join(lines, "\n"), nil
+}
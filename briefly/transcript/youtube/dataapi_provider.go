@@ -0,0 +1,169 @@
+package youtube
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+
+	"golang.org/x/oauth2"
+	"google.golang.org/api/option"
+	"google.golang.org/api/youtube/v3"
+
+	"github.com/olegshulyakov/go-briefly-bot/briefly/transcript/utils"
+)
+
+// dataAPIProvider implements TranscriptProvider using the official YouTube
+// Data API v3 `captions.list`/`captions.download` endpoints. It requires
+// either YOUTUBE_DATA_API_KEY (API-key auth, works only for captions the
+// uploader made public) or YOUTUBE_OAUTH_TOKEN (OAuth, required to download
+// a channel's own private captions).
+type dataAPIProvider struct {
+	apiKey     string
+	oauthToken string
+}
+
+// newDataAPIProvider reads its credentials from the environment. It is
+// always registered; FetchTranscript reports a clear error if neither
+// credential is configured, rather than failing at startup.
+func newDataAPIProvider() dataAPIProvider {
+	return dataAPIProvider{
+		apiKey:     os.Getenv("YOUTUBE_DATA_API_KEY"),
+		oauthToken: os.Getenv("YOUTUBE_OAUTH_TOKEN"),
+	}
+}
+
+// Name identifies this provider in YOUTUBE_TRANSCRIPT_PROVIDERS and in logs.
+func (dataAPIProvider) Name() string {
+	return "data-api"
+}
+
+// FetchTranscript retrieves the transcript of a YouTube video through the
+// YouTube Data API v3: it lists the video's caption tracks, picks the one
+// matching languageCode (falling back to the first available track), and
+// downloads it in SRT format.
+//
+// Parameters:
+//   - ctx: Used to cancel the underlying API calls.
+//   - videoURL: The URL of the YouTube video.
+//   - languageCode: The preferred subtitle language (e.g., "en", "ru").
+//
+// Returns:
+//   - A string containing the transcript of the video.
+//   - An error if no credentials are configured, the video has no captions,
+//     or the API request fails.
+//
+// Notes:
+//   - `captions.download` requires the caption track's owner to have made it
+//     public, or the caller to be authenticated as the video's owner via OAuth.
+func (p dataAPIProvider) FetchTranscript(ctx context.Context, videoURL string, languageCode string) (string, error) {
+	service, tracks, err := p.listCaptionTracks(ctx, videoURL)
+	if err != nil {
+		return "", err
+	}
+
+	track := selectCaptionTrack(tracks, languageCode)
+
+	slog.Debug("Downloading caption track", "url", videoURL, "provider", "data-api", "track", track.Id, "language", track.Snippet.Language)
+	call := service.Captions.Download(track.Id).Tfmt("srt")
+	resp, err := call.Download()
+	if err != nil {
+		return "", fmt.Errorf("data-api provider: failed to download caption track: %w", err)
+	}
+	defer resp.Body.Close()
+
+	transcript, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("data-api provider: failed to read caption track: %w", err)
+	}
+
+	cleaned, err := utils.CleanSRT(string(transcript))
+	if err != nil {
+		return "", fmt.Errorf("data-api provider: failed to clean caption track: %w", err)
+	}
+	return cleaned, nil
+}
+
+// ListLanguages returns the subtitle language codes the YouTube Data API v3
+// reports for videoURL via `captions.list`.
+//
+// Parameters:
+//   - ctx: Used to cancel the underlying API call.
+//   - videoURL: The URL of the YouTube video.
+//
+// Returns:
+//   - The distinct language codes available for the video.
+//   - An error if no credentials are configured, or the API request fails.
+func (p dataAPIProvider) ListLanguages(ctx context.Context, videoURL string) ([]string, error) {
+	_, tracks, err := p.listCaptionTracks(ctx, videoURL)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]bool, len(tracks))
+	languages := make([]string, 0, len(tracks))
+	for _, track := range tracks {
+		if seen[track.Snippet.Language] {
+			continue
+		}
+		seen[track.Snippet.Language] = true
+		languages = append(languages, track.Snippet.Language)
+	}
+	return languages, nil
+}
+
+// listCaptionTracks authenticates against the YouTube Data API v3 and lists
+// videoURL's caption tracks, shared by FetchTranscript and ListLanguages so
+// both go through the same auth and error handling.
+func (p dataAPIProvider) listCaptionTracks(ctx context.Context, videoURL string) (*youtube.Service, []*youtube.Caption, error) {
+	if p.apiKey == "" && p.oauthToken == "" {
+		return nil, nil, errors.New("data-api provider: neither YOUTUBE_DATA_API_KEY nor YOUTUBE_OAUTH_TOKEN is set")
+	}
+	if !IsValidYouTubeURL(videoURL) {
+		return nil, nil, fmt.Errorf("no valid URL found: %s", videoURL)
+	}
+
+	videoID, err := GetYouTubeID(videoURL)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	service, err := p.newService(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("data-api provider: failed to create client: %w", err)
+	}
+
+	slog.Debug("Listing caption tracks", "url", videoURL, "provider", "data-api")
+	list, err := service.Captions.List([]string{"snippet"}, videoID).Context(ctx).Do()
+	if err != nil {
+		return nil, nil, fmt.Errorf("data-api provider: failed to list captions: %w", err)
+	}
+	if len(list.Items) == 0 {
+		return nil, nil, fmt.Errorf("data-api provider: video %s has no caption tracks", videoID)
+	}
+
+	return service, list.Items, nil
+}
+
+// newService builds a youtube.Service using whichever credential is configured,
+// preferring OAuth (required for private captions) over an API key.
+func (p dataAPIProvider) newService(ctx context.Context) (*youtube.Service, error) {
+	if p.oauthToken != "" {
+		ts := oauth2.StaticTokenSource(&oauth2.Token{AccessToken: p.oauthToken})
+		return youtube.NewService(ctx, option.WithTokenSource(ts))
+	}
+	return youtube.NewService(ctx, option.WithAPIKey(p.apiKey))
+}
+
+// selectCaptionTrack returns the caption track matching languageCode, or the
+// first available track if no match is found.
+func selectCaptionTrack(tracks []*youtube.Caption, languageCode string) *youtube.Caption {
+	for _, track := range tracks {
+		if languageCode != "" && track.Snippet.Language == languageCode {
+			return track
+		}
+	}
+	return tracks[0]
+}
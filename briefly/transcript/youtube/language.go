@@ -0,0 +1,81 @@
+package youtube
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/abadojack/whatlanggo"
+)
+
+// availableLanguages returns the set of subtitle language codes available
+// for videoURL, asking each configured TranscriptProvider in turn (same
+// order and fallback-on-failure behavior as fetchTranscriptFromProviders) so
+// enumeration respects YOUTUBE_TRANSCRIPT_PROVIDERS instead of hard-depending
+// on yt-dlp.
+//
+// Parameters:
+//   - ctx: Used to cancel in-flight provider requests.
+//   - videoURL: The URL of the YouTube video.
+//
+// Returns:
+//   - The distinct language codes available for the video, from the first
+//     provider that could enumerate them.
+//   - An error if every configured provider fails to enumerate languages.
+func availableLanguages(ctx context.Context, videoURL string) ([]string, error) {
+	var lastErr error
+
+	for _, name := range providerOrder {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+
+		provider, ok := providers[strings.TrimSpace(name)]
+		if !ok {
+			continue
+		}
+
+		languages, err := provider.ListLanguages(ctx, videoURL)
+		if err == nil {
+			return languages, nil
+		}
+
+		slog.Warn("Transcript provider failed to list languages, trying next", "provider", provider.Name(), "url", videoURL, "error", err)
+		lastErr = err
+	}
+
+	if lastErr == nil {
+		return nil, fmt.Errorf("no transcript provider configured")
+	}
+	return nil, fmt.Errorf("all transcript providers failed to list languages: %w", lastErr)
+}
+
+// pickPreferredLanguage returns the first entry of preferred that is present
+// in available, preserving the caller's preference order.
+//
+// Returns:
+//   - The matching language code and true, or "" and false if none of the
+//     preferred languages are available.
+func pickPreferredLanguage(available []string, preferred []string) (string, bool) {
+	availableSet := make(map[string]bool, len(available))
+	for _, lang := range available {
+		availableSet[lang] = true
+	}
+
+	for _, lang := range preferred {
+		if availableSet[lang] {
+			return lang, true
+		}
+	}
+	return "", false
+}
+
+// detectLanguage returns the BCP-47 language code whatlanggo considers most
+// likely for text. It is used as a last resort when none of the caller's
+// preferred languages have a matching subtitle track, so the caller still
+// learns which language the downloaded transcript is actually in.
+func detectLanguage(text string) string {
+	info := whatlanggo.Detect(text)
+	return info.Lang.Iso6391()
+}
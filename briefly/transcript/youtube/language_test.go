@@ -0,0 +1,31 @@
+package youtube
+
+import "testing"
+
+func TestPickPreferredLanguageMatch(t *testing.T) {
+	lang, ok := pickPreferredLanguage([]string{"en", "ru", "ja"}, []string{"ru", "en"})
+	if !ok || lang != "ru" {
+		t.Fatalf("pickPreferredLanguage(...) = (%q, %v), want (\"ru\", true)", lang, ok)
+	}
+}
+
+func TestPickPreferredLanguageNoMatch(t *testing.T) {
+	lang, ok := pickPreferredLanguage([]string{"en", "ru"}, []string{"ja", "de"})
+	if ok || lang != "" {
+		t.Fatalf("pickPreferredLanguage(...) = (%q, %v), want (\"\", false)", lang, ok)
+	}
+}
+
+func TestPickPreferredLanguageEmptyPreferences(t *testing.T) {
+	lang, ok := pickPreferredLanguage([]string{"en", "ru"}, nil)
+	if ok || lang != "" {
+		t.Fatalf("pickPreferredLanguage(available, nil) = (%q, %v), want (\"\", false)", lang, ok)
+	}
+}
+
+func TestPickPreferredLanguageEmptyAvailable(t *testing.T) {
+	lang, ok := pickPreferredLanguage(nil, []string{"en", "ru"})
+	if ok || lang != "" {
+		t.Fatalf("pickPreferredLanguage(nil, preferred) = (%q, %v), want (\"\", false)", lang, ok)
+	}
+}
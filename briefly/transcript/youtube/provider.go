@@ -0,0 +1,183 @@
+package youtube
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/olegshulyakov/go-briefly-bot/briefly/cache"
+)
+
+// transcriptCacheTTL bounds how long a cached transcript is served before
+// GetYoutubeTranscript re-fetches it from the configured providers.
+const transcriptCacheTTL = 7 * 24 * time.Hour
+
+// transcriptCacheStore returns the process-wide cache (shared with the
+// summary cache, so a CACHE_BACKEND=bolt deployment opens its database file
+// once, not once per cache user).
+func transcriptCacheStore() cache.Store {
+	return cache.Default()
+}
+
+// TranscriptProvider retrieves a YouTube video's transcript through a
+// specific backend (a subprocess, an API, or an HTML scrape). Implementations
+// are registered in providers and selected, in order, by providerOrder.
+type TranscriptProvider interface {
+	// Name identifies the provider in YOUTUBE_TRANSCRIPT_PROVIDERS and in logs.
+	Name() string
+	// FetchTranscript returns the transcript for videoURL in languageCode,
+	// or an error if this provider could not produce one. ctx cancels the
+	// underlying request (a subprocess, an API call, or an HTTP fetch).
+	FetchTranscript(ctx context.Context, videoURL string, languageCode string) (string, error)
+	// ListLanguages returns the subtitle language codes this provider can
+	// see for videoURL, without depending on any other provider's backend.
+	ListLanguages(ctx context.Context, videoURL string) ([]string, error)
+}
+
+// providers holds every known TranscriptProvider, keyed by Name().
+var providers = map[string]TranscriptProvider{}
+
+// providerOrder is the sequence of provider names GetYoutubeTranscript tries,
+// configured via YOUTUBE_TRANSCRIPT_PROVIDERS (comma-separated, e.g.
+// "yt-dlp,data-api,scrape"). It defaults to the `yt-dlp` provider alone so
+// existing deployments keep their current behavior.
+var providerOrder []string
+
+func init() {
+	registerProvider(ytDlpProvider{})
+	registerProvider(newDataAPIProvider())
+	registerProvider(scrapeProvider{})
+
+	providerOrder = strings.Split(os.Getenv("YOUTUBE_TRANSCRIPT_PROVIDERS"), ",")
+	if len(providerOrder) == 1 && providerOrder[0] == "" {
+		providerOrder = []string{ytDlpProvider{}.Name()}
+	}
+}
+
+// registerProvider adds p to providers, keyed by its Name().
+func registerProvider(p TranscriptProvider) {
+	providers[p.Name()] = p
+}
+
+// GetYoutubeTranscript retrieves the transcript of a YouTube video using its
+// URL, negotiating the subtitle language from the caller's preferences and
+// falling back across each configured TranscriptProvider in order.
+//
+// The function performs the following steps:
+//  1. Enumerates the video's available subtitle languages.
+//  2. Picks the first of preferredLanguages that is available; if none
+//     match (or enumeration failed), it asks the providers for whatever
+//     track they can find.
+//  3. Fetches the transcript for the chosen language from each configured
+//     provider in turn, falling back to the next on failure.
+//  4. If no preferred language matched, runs language detection on the
+//     downloaded transcript so the caller still learns its actual language.
+//
+// Parameters:
+//   - ctx: Used to cancel in-flight provider requests.
+//   - videoURL: The URL of the YouTube video.
+//   - preferredLanguages: Languages to try, in order of preference (e.g., "en", "ru").
+//
+// Returns:
+//   - A string containing the transcript of the video.
+//   - The BCP-47 language code of the returned transcript: the matched
+//     preference, or a detected code when none matched.
+//   - An error if every configured provider fails to produce a transcript.
+//
+// Example:
+//
+//	transcript, lang, err := GetYoutubeTranscript(ctx, "https://www.youtube.com/watch?v=example", []string{"en", "ru"})
+//	if err != nil {
+//	    log.Errorf("Failed to get transcript: %v", err)
+//	}
+//	fmt.Printf("Transcript (%s): %s\n", lang, transcript)
+//
+// Notes:
+//   - The provider order is configured via YOUTUBE_TRANSCRIPT_PROVIDERS and
+//     defaults to the `yt-dlp` provider alone.
+//   - Unknown provider names in YOUTUBE_TRANSCRIPT_PROVIDERS are skipped with
+//     a warning rather than failing the whole request.
+func GetYoutubeTranscript(ctx context.Context, videoURL string, preferredLanguages []string) (string, string, error) {
+	languageCode := ""
+	matched := false
+
+	if available, err := availableLanguages(ctx, videoURL); err != nil {
+		slog.Warn("Failed to enumerate subtitle languages, falling back to provider defaults", "url", videoURL, "error", err)
+	} else if lang, ok := pickPreferredLanguage(available, preferredLanguages); ok {
+		languageCode, matched = lang, true
+	}
+
+	videoID, idErr := GetYouTubeID(videoURL)
+	store := transcriptCacheStore()
+
+	if idErr == nil && store != nil {
+		cacheKey := cache.TranscriptKey(videoID, languageCode)
+		if cached, ok, err := store.Get(ctx, cacheKey); err != nil {
+			slog.Warn("Transcript cache read failed, fetching fresh", "url", videoURL, "error", err)
+		} else if ok {
+			slog.Debug("Transcript cache hit", "url", videoURL, "language", languageCode)
+			resultLang := languageCode
+			if !matched {
+				resultLang = detectLanguage(string(cached))
+			}
+			return string(cached), resultLang, nil
+		}
+	}
+
+	transcript, err := fetchTranscriptFromProviders(ctx, videoURL, languageCode)
+	if err != nil {
+		return "", "", err
+	}
+
+	if idErr == nil && store != nil {
+		cacheKey := cache.TranscriptKey(videoID, languageCode)
+		if err := store.Put(ctx, cacheKey, []byte(transcript), transcriptCacheTTL); err != nil {
+			slog.Warn("Failed to cache transcript", "url", videoURL, "error", err)
+		} else if err := cache.IndexForVideo(ctx, store, videoID, cacheKey); err != nil {
+			slog.Warn("Failed to index transcript cache entry for purge", "url", videoURL, "error", err)
+		}
+	}
+
+	if matched {
+		return transcript, languageCode, nil
+	}
+
+	detected := detectLanguage(transcript)
+	slog.Debug("No preferred language matched, detected language from transcript", "url", videoURL, "detected", detected)
+	return transcript, detected, nil
+}
+
+// fetchTranscriptFromProviders tries each configured TranscriptProvider in
+// order for languageCode, falling back to the next one on failure.
+func fetchTranscriptFromProviders(ctx context.Context, videoURL string, languageCode string) (string, error) {
+	var lastErr error
+
+	for _, name := range providerOrder {
+		if ctx.Err() != nil {
+			return "", ctx.Err()
+		}
+
+		provider, ok := providers[strings.TrimSpace(name)]
+		if !ok {
+			slog.Warn("Unknown transcript provider, skipping", "provider", name)
+			continue
+		}
+
+		slog.Debug("Trying transcript provider", "provider", provider.Name(), "url", videoURL)
+		transcript, err := provider.FetchTranscript(ctx, videoURL, languageCode)
+		if err == nil {
+			return transcript, nil
+		}
+
+		slog.Warn("Transcript provider failed, trying next", "provider", provider.Name(), "url", videoURL, "error", err)
+		lastErr = err
+	}
+
+	if lastErr == nil {
+		return "", fmt.Errorf("no transcript provider configured")
+	}
+	return "", fmt.Errorf("all transcript providers failed: %w", lastErr)
+}
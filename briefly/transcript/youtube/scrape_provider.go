@@ -0,0 +1,201 @@
+package youtube
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/olegshulyakov/go-briefly-bot/briefly/transcript/utils"
+)
+
+// scrapeProvider implements TranscriptProvider by fetching the YouTube watch
+// page directly and parsing the caption tracks out of the embedded
+// `ytInitialPlayerResponse` JSON blob. It needs neither `yt-dlp` nor API
+// credentials, making it a last-resort fallback when both are unavailable.
+type scrapeProvider struct{}
+
+// Name identifies this provider in YOUTUBE_TRANSCRIPT_PROVIDERS and in logs.
+func (scrapeProvider) Name() string {
+	return "scrape"
+}
+
+// playerResponse is the subset of `ytInitialPlayerResponse` this provider cares about.
+type playerResponse struct {
+	Captions struct {
+		PlayerCaptionsTracklistRenderer struct {
+			CaptionTracks []captionTrack `json:"captionTracks"`
+		} `json:"playerCaptionsTracklistRenderer"`
+	} `json:"captions"`
+}
+
+// captionTrack describes one caption track embedded in `ytInitialPlayerResponse`.
+type captionTrack struct {
+	BaseURL      string `json:"baseUrl"`
+	LanguageCode string `json:"languageCode"`
+	Kind         string `json:"kind"`
+}
+
+// timedText mirrors the minimal XML schema returned by YouTube's `timedtext` endpoint.
+type timedText struct {
+	XMLName xml.Name `xml:"transcript"`
+	Texts   []struct {
+		Text string `xml:",chardata"`
+	} `xml:"text"`
+}
+
+// FetchTranscript retrieves the transcript of a YouTube video by scraping
+// its watch page for caption tracks and downloading the matching timedtext
+// XML document.
+//
+// Parameters:
+//   - ctx: Used to cancel the underlying HTTP requests.
+//   - videoURL: The URL of the YouTube video.
+//   - languageCode: The preferred subtitle language (e.g., "en", "ru").
+//
+// Returns:
+//   - A string containing the transcript of the video.
+//   - An error if the watch page cannot be fetched, no caption tracks are
+//     embedded in it, or the timedtext document cannot be downloaded or parsed.
+//
+// Notes:
+//   - This provider depends on the undocumented `ytInitialPlayerResponse` and
+//     `timedtext` formats, which YouTube may change without notice.
+func (scrapeProvider) FetchTranscript(ctx context.Context, videoURL string, languageCode string) (string, error) {
+	tracks, err := scrapeCaptionTracks(ctx, videoURL)
+	if err != nil {
+		return "", err
+	}
+
+	baseURL := tracks[0].BaseURL
+	for _, track := range tracks {
+		if languageCode != "" && track.LanguageCode == languageCode {
+			baseURL = track.BaseURL
+			break
+		}
+	}
+
+	slog.Debug("Downloading timedtext track", "url", videoURL, "provider", "scrape", "track", baseURL)
+	xmlDoc, err := httpGetString(ctx, baseURL)
+	if err != nil {
+		return "", fmt.Errorf("scrape provider: failed to download timedtext track: %w", err)
+	}
+
+	var parsed timedText
+	if err := xml.Unmarshal([]byte(xmlDoc), &parsed); err != nil {
+		return "", fmt.Errorf("scrape provider: failed to parse timedtext document: %w", err)
+	}
+
+	lines := make([]string, 0, len(parsed.Texts))
+	for _, t := range parsed.Texts {
+		lines = append(lines, t.Text)
+	}
+
+	return utils.CleanText(strings.Join(lines, "\n"))
+}
+
+// ListLanguages returns the subtitle language codes embedded in videoURL's
+// watch page `ytInitialPlayerResponse`.
+//
+// Parameters:
+//   - ctx: Used to cancel the underlying HTTP request.
+//   - videoURL: The URL of the YouTube video.
+//
+// Returns:
+//   - The distinct language codes available for the video.
+//   - An error if the watch page cannot be fetched or has no caption tracks.
+func (scrapeProvider) ListLanguages(ctx context.Context, videoURL string) ([]string, error) {
+	tracks, err := scrapeCaptionTracks(ctx, videoURL)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]bool, len(tracks))
+	languages := make([]string, 0, len(tracks))
+	for _, track := range tracks {
+		if seen[track.LanguageCode] {
+			continue
+		}
+		seen[track.LanguageCode] = true
+		languages = append(languages, track.LanguageCode)
+	}
+	return languages, nil
+}
+
+// scrapeCaptionTracks fetches videoURL's watch page and extracts its
+// embedded caption tracks, shared by FetchTranscript and ListLanguages.
+func scrapeCaptionTracks(ctx context.Context, videoURL string) ([]captionTrack, error) {
+	if !IsValidYouTubeURL(videoURL) {
+		return nil, fmt.Errorf("no valid URL found: %s", videoURL)
+	}
+
+	slog.Debug("Fetching watch page", "url", videoURL, "provider", "scrape")
+	html, err := httpGetString(ctx, videoURL)
+	if err != nil {
+		return nil, fmt.Errorf("scrape provider: failed to fetch watch page: %w", err)
+	}
+
+	player, err := extractPlayerResponse(html)
+	if err != nil {
+		return nil, fmt.Errorf("scrape provider: %w", err)
+	}
+
+	tracks := player.Captions.PlayerCaptionsTracklistRenderer.CaptionTracks
+	if len(tracks) == 0 {
+		return nil, fmt.Errorf("scrape provider: no caption tracks found for %s", videoURL)
+	}
+	return tracks, nil
+}
+
+// extractPlayerResponse finds and decodes the `ytInitialPlayerResponse` JSON
+// object embedded in a YouTube watch page's HTML.
+func extractPlayerResponse(html string) (*playerResponse, error) {
+	const marker = "ytInitialPlayerResponse ="
+	start := strings.Index(html, marker)
+	if start == -1 {
+		return nil, fmt.Errorf("ytInitialPlayerResponse not found in watch page")
+	}
+	start += len(marker)
+
+	end := strings.Index(html[start:], ";var ")
+	if end == -1 {
+		end = strings.Index(html[start:], ";</script>")
+	}
+	if end == -1 {
+		return nil, fmt.Errorf("could not locate end of ytInitialPlayerResponse")
+	}
+
+	var player playerResponse
+	if err := json.Unmarshal([]byte(html[start:start+end]), &player); err != nil {
+		return nil, fmt.Errorf("failed to decode ytInitialPlayerResponse: %w", err)
+	}
+	return &player, nil
+}
+
+// httpGetString fetches url and returns its body as a string.
+func httpGetString(ctx context.Context, url string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("unexpected status %s", resp.Status)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+	return string(body), nil
+}
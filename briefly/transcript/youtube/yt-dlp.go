@@ -1,9 +1,12 @@
 package youtube
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"log/slog"
 	"os"
 	"os/exec"
@@ -22,11 +25,21 @@ func init() {
 
 // VideoInfo represents metadata about a YouTube video.
 type VideoInfo struct {
-	ID        string `json:"id"`        // The unique identifier of the video.
-	Language  string `json:"language"`  // The video language.
-	Uploader  string `json:"uploader"`  // The name of the video uploader.
-	Title     string `json:"title"`     // The title of the video.
-	Thumbnail string `json:"thumbnail"` // The URL of the video's thumbnail.
+	ID                string                      `json:"id"`                 // The unique identifier of the video.
+	Language          string                      `json:"language"`           // The video language.
+	Uploader          string                      `json:"uploader"`           // The name of the video uploader.
+	Title             string                      `json:"title"`              // The title of the video.
+	Thumbnail         string                      `json:"thumbnail"`          // The URL of the video's thumbnail.
+	Subtitles         map[string][]SubtitleFormat `json:"subtitles"`          // Manually-authored subtitle tracks, keyed by language code.
+	AutomaticCaptions map[string][]SubtitleFormat `json:"automatic_captions"` // Auto-generated caption tracks, keyed by language code.
+}
+
+// SubtitleFormat describes one available encoding of a subtitle track, as
+// reported by `yt-dlp --dump-json`.
+type SubtitleFormat struct {
+	Ext  string `json:"ext"`  // The subtitle file extension (e.g., "srt", "vtt").
+	URL  string `json:"url"`  // The direct download URL for this format, if any.
+	Name string `json:"name"` // The human-readable track name (e.g., "English (auto-generated)").
 }
 
 // GetYoutubeVideoInfo retrieves metadata about a YouTube video using its URL.
@@ -35,6 +48,7 @@ type VideoInfo struct {
 // in JSON format and then parses it into a VideoInfo struct.
 //
 // Parameters:
+//   - ctx: Used to cancel the underlying `yt-dlp` process.
 //   - videoURL: The URL of the YouTube video.
 //
 // Returns:
@@ -43,7 +57,7 @@ type VideoInfo struct {
 //
 // Example:
 //
-//	videoInfo, err := GetYoutubeVideoInfo("https://www.youtube.com/watch?v=example")
+//	videoInfo, err := GetYoutubeVideoInfo(ctx, "https://www.youtube.com/watch?v=example")
 //	if err != nil {
 //	    log.Errorf("Failed to get video info: %v", err)
 //	}
@@ -51,7 +65,7 @@ type VideoInfo struct {
 //
 // Notes:
 //   - The function relies on the `yt-dlp` tool being installed and accessible in the system's PATH.
-func GetYoutubeVideoInfo(videoURL string) (*VideoInfo, error) {
+func GetYoutubeVideoInfo(ctx context.Context, videoURL string) (*VideoInfo, error) {
 	if videoURL == "" {
 		return nil, errors.New("videoURL is empty")
 	}
@@ -67,7 +81,7 @@ func GetYoutubeVideoInfo(videoURL string) (*VideoInfo, error) {
 		"--dump-json",
 	}
 
-	output, err := execYtDlp(args, videoURL)
+	output, err := execYtDlp(ctx, args, videoURL)
 	if err != nil {
 		return nil, fmt.Errorf("failed to extract video info: %w", err)
 	}
@@ -82,21 +96,67 @@ func GetYoutubeVideoInfo(videoURL string) (*VideoInfo, error) {
 	return videoInfo, nil
 }
 
-// GetYoutubeTranscript retrieves the transcript of a YouTube video using its URL.
+// ytDlpProvider implements TranscriptProvider using the `yt-dlp` command-line
+// tool. It is always registered and is the default (and historically only)
+// provider.
+type ytDlpProvider struct{}
+
+// Name identifies this provider in YOUTUBE_TRANSCRIPT_PROVIDERS and in logs.
+func (ytDlpProvider) Name() string {
+	return "yt-dlp"
+}
+
+// ListLanguages returns the subtitle language codes `yt-dlp --dump-json`
+// reports for videoURL, combining manually-authored subtitles and
+// auto-generated captions.
+//
+// Parameters:
+//   - ctx: Used to cancel the underlying `yt-dlp` process.
+//   - videoURL: The URL of the YouTube video.
+//
+// Returns:
+//   - The distinct language codes available for the video.
+//   - An error if the video's metadata cannot be retrieved.
+func (ytDlpProvider) ListLanguages(ctx context.Context, videoURL string) ([]string, error) {
+	info, err := GetYoutubeVideoInfo(ctx, videoURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to enumerate subtitle tracks: %w", err)
+	}
+
+	seen := make(map[string]bool, len(info.Subtitles)+len(info.AutomaticCaptions))
+	for lang := range info.Subtitles {
+		seen[lang] = true
+	}
+	for lang := range info.AutomaticCaptions {
+		seen[lang] = true
+	}
+
+	languages := make([]string, 0, len(seen))
+	for lang := range seen {
+		languages = append(languages, lang)
+	}
+	return languages, nil
+}
+
+// FetchTranscript retrieves the transcript of a YouTube video using its URL.
 //
 // The function uses the `yt-dlp` command-line tool to extract the transcript
-// in SRT format, reads the transcript file, and then deletes the file.
+// in SRT format, streaming it over the subprocess's stdout pipe instead of
+// writing it to a temporary file. This avoids the file-naming race that two
+// concurrent requests for the same videoID used to hit.
 //
 // Parameters:
+//   - ctx: Used to cancel the underlying `yt-dlp` process.
 //   - videoURL: The URL of the YouTube video.
+//   - languageCode: The preferred subtitle language (e.g., "en", "ru").
 //
 // Returns:
 //   - A string containing the transcript of the video.
-//   - An error if the transcript cannot be extracted, read, or the file cannot be deleted.
+//   - An error if the transcript cannot be extracted or parsed.
 //
 // Example:
 //
-//	transcript, err := GetYoutubeTranscript("https://www.youtube.com/watch?v=example")
+//	transcript, err := (ytDlpProvider{}).FetchTranscript(ctx, "https://www.youtube.com/watch?v=example", "en")
 //	if err != nil {
 //	    log.Errorf("Failed to get transcript: %v", err)
 //	}
@@ -104,24 +164,30 @@ func GetYoutubeVideoInfo(videoURL string) (*VideoInfo, error) {
 //
 // Notes:
 //   - The function relies on the `yt-dlp` tool being installed and accessible in the system's PATH.
-//   - The transcript is extracted in Russian (`ru` and `ru_auto`) and saved as an SRT file.
-//   - The transcript file is deleted after reading to clean up temporary files.
-func GetYoutubeTranscript(videoURL string, languageCode string) (string, error) {
+func (ytDlpProvider) FetchTranscript(ctx context.Context, videoURL string, languageCode string) (string, error) {
 	const extension = "srt"
 
 	if videoURL == "" {
 		return "", errors.New("videoURL is empty")
 	}
-	if languageCode != "" {
-		languageCode = "en"
-	}
 	if !IsValidYouTubeURL(videoURL) {
 		return "", fmt.Errorf("no valid URL found: %s", videoURL)
 	}
 
-	videoID, err := GetYouTubeID(videoURL)
-	if err != nil {
-		return "", err
+	// An empty languageCode means no preferred language matched one of the
+	// video's tracks; fall back to whatever track this provider can see
+	// (GetYoutubeTranscript runs language detection on the result) instead
+	// of guessing "en", which fails outright for videos with no English
+	// subtitles at all. If even enumeration fails, request every track.
+	if languageCode == "" {
+		if available, err := (ytDlpProvider{}).ListLanguages(ctx, videoURL); err == nil && len(available) > 0 {
+			languageCode = available[0]
+		}
+	}
+
+	subLang := "all,-live_chat"
+	if languageCode != "" {
+		subLang = fmt.Sprintf("%s,%s_auto,-live_chat", languageCode, languageCode)
 	}
 
 	args := []string{
@@ -130,35 +196,38 @@ func GetYoutubeTranscript(videoURL string, languageCode string) (string, error)
 		"--write-subs",
 		"--write-auto-subs",
 		"--convert-subs", extension,
-		"--sub-lang", fmt.Sprintf("%s,%s_auto,-live_chat", languageCode, languageCode),
-		"--output", fmt.Sprintf("subtitles_%s.%%(ext)s", videoID),
+		"--sub-lang", subLang,
+		"--output", "-",
 	}
 
-	slog.Debug("Transcript extract", "url", videoURL)
-	output, err := execYtDlp(args, videoURL)
+	slog.Debug("Transcript extract", "url", videoURL, "provider", "yt-dlp")
+	output, err := execYtDlp(ctx, args, videoURL)
 	if err != nil {
 		return "", fmt.Errorf("failed to extract transcript: %w\n%s", err, output)
 	}
 
-	// Read the transcript file
-	transcript, err := utils.ReadAndRemoveFile(fmt.Sprintf("subtitles_%s.%s.%s", videoID, languageCode, extension))
+	slog.Debug("Transcript extracted", "url", videoURL, "provider", "yt-dlp")
+
+	transcript, err := utils.DecodeSRT(bytes.NewReader(output))
 	if err != nil {
-		return "", err
+		return "", fmt.Errorf("failed to decode transcript stream: %w", err)
 	}
 
-	slog.Debug("Transcript extracted", "url", videoURL)
-
 	cleaned, err := utils.CleanSRT(transcript)
 	if err != nil {
-		return "", fmt.Errorf("failed to clean transcript file: %w", err)
+		return "", fmt.Errorf("failed to clean transcript: %w", err)
 	}
 
-	slog.Debug("Transcript cleaned", "url", videoURL)
+	slog.Debug("Transcript cleaned", "url", videoURL, "provider", "yt-dlp")
 
 	return cleaned, nil
 }
 
-func execYtDlp(arguments []string, url string) ([]byte, error) {
+// execYtDlp runs `yt-dlp` with the given arguments, streaming its stdout
+// through a pipe rather than buffering via cmd.Output (stderr is captured
+// separately for error reporting), and retrying on failure. ctx cancels an in-flight run, including while a retry is waiting
+// to start.
+func execYtDlp(ctx context.Context, arguments []string, url string) ([]byte, error) {
 	const maxAttempts = 3
 	var (
 		err    error
@@ -174,8 +243,11 @@ func execYtDlp(arguments []string, url string) ([]byte, error) {
 
 	// Execute with retry
 	for attempt := 0; attempt < maxAttempts; attempt++ {
-		cmd := exec.Command("yt-dlp", args...)
-		output, err = cmd.Output()
+		if ctx.Err() != nil {
+			return output, ctx.Err()
+		}
+
+		output, err = runYtDlp(ctx, args)
 		if err == nil {
 			break
 		}
@@ -187,3 +259,34 @@ func execYtDlp(arguments []string, url string) ([]byte, error) {
 
 	return output, nil
 }
+
+// runYtDlp executes a single `yt-dlp` invocation, streaming stdout into
+// memory through a pipe (rather than exec's buffered Output) and capturing
+// stderr separately for error reporting.
+func runYtDlp(ctx context.Context, args []string) ([]byte, error) {
+	cmd := exec.CommandContext(ctx, "yt-dlp", args...)
+
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, fmt.Errorf("failed to open stdout pipe: %w", err)
+	}
+
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	if err := cmd.Start(); err != nil {
+		return nil, fmt.Errorf("failed to start yt-dlp: %w", err)
+	}
+
+	output, readErr := io.ReadAll(stdout)
+	waitErr := cmd.Wait()
+
+	if waitErr != nil {
+		return output, fmt.Errorf("%w: %s", waitErr, stderr.String())
+	}
+	if readErr != nil {
+		return output, fmt.Errorf("failed to read yt-dlp stdout: %w", readErr)
+	}
+
+	return output, nil
+}